@@ -1,7 +1,6 @@
 package bdd
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/ddsgok/bdd/internal/golden"
@@ -10,31 +9,46 @@ import (
 
 // Given defines one Feature's specific context to be tested.
 func Given(t *testing.T, given string, args ...interface{}) {
+	if t != nil {
+		t.Helper()
+	}
+
 	gTestBodies, gTestCases := split(S(), args)
 	whenFunc := gTestBodies.asWhenFunc()
 
 	for _, gArgs := range gTestCases {
 		// setup the testspec that we will be using
 		testspec := spec.New(t, feature(), printf(given, gArgs))
+		testspec.File, testspec.GivenLine = declaredAt()
 		testspec.PrintFeature()
 		testspec.PrintContext()
 
 		if whenFunc != nil {
 			whenFunc(func(when string, args ...interface{}) {
+				_, whenLine := declaredAt()
 				wTestBodies, wTestCases := split(gArgs, args)
 				itFunc := wTestBodies.asItFuncs()
 
 				for _, wArgs := range wTestCases {
 					testspec.When = printf(when, wArgs)
+					testspec.WhenLine = whenLine
 					testspec.PrintWhen()
 
 					if itFunc != nil {
 						itFunc(func(it string, args ...interface{}) {
+							_, itLine := declaredAt()
 							iTestBodies, iTestCases := split(wArgs, args)
-							assertFunc := iTestBodies.asAssertFunc()
+							skipped := iTestBodies.isSkipped()
+							var assertFunc func(Assert, ...interface{})
+							if !skipped {
+								assertFunc = iTestBodies.asAssertFunc()
+							}
 
 							for _, iArgs := range iTestCases {
 								testspec.It = printf(it, iArgs)
+								testspec.Args = iArgs
+								testspec.ItLine = itLine
+								testspec.Skipped = skipped
 								// It output is handled in the testspec.Run() below
 
 								if assertFunc != nil {
@@ -55,41 +69,54 @@ func Given(t *testing.T, given string, args ...interface{}) {
 								testspec.Run()
 							}
 						}, wArgs...)
+					} else {
+						testspec.Undefined()
 					}
 				}
 			}, gArgs...)
+		} else {
+			testspec.Undefined()
 		}
 
-		// reset to default
-		spec.Config().ResetLasts()
-
-		if spec.Config().Output != spec.OutputNone {
-			fmt.Println()
-		}
+		// inform end of context and reset to default
+		testspec.Finish()
 	}
 }
 
 // GivenWithGolden defines one Feature's specific context to be tested.
 func GivenWithGolden(t *testing.T, given string, args ...interface{}) {
+	if t != nil {
+		t.Helper()
+	}
+
 	goldenFunc := newTestFunc(args...).asGoldenFunc()
 	feature := feature()
+	file, line := declaredAt()
 	gm := golden.NewManager(feature, given)
 
 	if goldenFunc != nil {
 		for i := 0; i < gm.NumGoldies(); i++ {
 			testspec := spec.New(t, feature, gprintf(given, gm.Get(i)))
+			testspec.File, testspec.GivenLine = file, line
 			testspec.PrintFeature()
 			testspec.PrintContext()
 
 			goldenFunc(func(when string, wTestBodies ...interface{}) {
 				itFunc := newTestFunc(wTestBodies...).asItFuncs()
 				testspec.When = gprintf(when, gm.Get(i))
+				_, testspec.WhenLine = declaredAt()
 				testspec.PrintWhen()
 
 				if itFunc != nil {
 					itFunc(func(it string, iTestBodies ...interface{}) {
-						assertFunc := newTestFunc(iTestBodies...).asAssertFunc()
+						tf := newTestFunc(iTestBodies...)
+						testspec.Skipped = tf.isSkipped()
+						var assertFunc func(Assert, ...interface{})
+						if !testspec.Skipped {
+							assertFunc = tf.asAssertFunc()
+						}
 						testspec.It = gprintf(it, gm.Get(i))
+						_, testspec.ItLine = declaredAt()
 
 						if assertFunc != nil {
 							testspec.AssertFn = func(a Assert) {
@@ -103,26 +130,34 @@ func GivenWithGolden(t *testing.T, given string, args ...interface{}) {
 
 						testspec.Run()
 					})
+				} else {
+					testspec.Undefined()
 				}
 			}, gm.Get(i))
+
+			testspec.Finish()
 		}
+	} else {
+		testspec := spec.New(t, feature, given)
+		testspec.File, testspec.GivenLine = file, line
+		testspec.PrintFeature()
+		testspec.PrintContext()
+		testspec.Undefined()
+		testspec.Finish()
 	}
 
 	gm.Update()
-
-	spec.Config().ResetLasts()
-	if spec.Config().Output != spec.OutputNone {
-		fmt.Println()
-	}
 }
 
 // Setup is used to define before/after (setup/teardown) functions.
+// After runs even when the verification is stopped by a failed
+// assertion of Require().
 func Setup(before, after func()) (fn func(fn func(Assert)) func(Assert)) {
 	fn = func(fn func(Assert)) func(Assert) {
 		before()
 		return func(assert Assert) {
+			defer after()
 			fn(assert)
-			after()
 		}
 	}
 	return
@@ -0,0 +1,151 @@
+package colors
+
+import (
+	"fmt"
+)
+
+const (
+	// kindBasic is a color of the 16 colors palette.
+	kindBasic colorKind = iota
+	// kindIndex is a color of the 256 colors palette.
+	kindIndex
+	// kindRGB is a 24-bit color.
+	kindRGB
+)
+
+// colorKind tells how a color was defined.
+type colorKind int
+
+// Color is a color to be used as foreground or background of a Style,
+// downgraded to the level supported by the terminal when printed.
+type Color struct {
+	kind    colorKind
+	index   uint8
+	r, g, b uint8
+}
+
+var (
+	// basicRGB are the approximate values of the 16 colors palette,
+	// used to downgrade colors.
+	basicRGB = [16][3]uint8{
+		{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+		{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+		{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+		{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+	}
+)
+
+// Basic creates a color of the 16 colors palette, where 0 to 7 are
+// black, red, green, yellow, blue, magenta, cyan and white, and 8 to 15
+// are their bright versions.
+func Basic(n uint8) (c Color) {
+	c = Color{kind: kindBasic, index: n % 16}
+	return
+}
+
+// Index creates a color of the 256 colors palette of xterm.
+func Index(n uint8) (c Color) {
+	c = Color{kind: kindIndex, index: n}
+	return
+}
+
+// RGB creates a 24-bit color.
+//
+//    colors.Style().Fg(colors.RGB(255, 128, 0)).Sprint("orange")
+func RGB(r, g, b uint8) (c Color) {
+	c = Color{kind: kindRGB, r: r, g: g, b: b}
+	return
+}
+
+// rgb returns red, green and blue values of color.
+func (c Color) rgb() (r, g, b uint8) {
+	switch {
+	case c.kind == kindRGB:
+		r, g, b = c.r, c.g, c.b
+	case c.kind == kindBasic || c.index < 16:
+		v := basicRGB[c.index%16]
+		r, g, b = v[0], v[1], v[2]
+	case c.index >= 232:
+		r = 8 + 10*(c.index-232)
+		g, b = r, r
+	default:
+		cube := func(v uint8) (n uint8) {
+			if v > 0 {
+				n = 55 + 40*v
+			}
+			return
+		}
+		i := c.index - 16
+		r, g, b = cube(i/36), cube(i/6%6), cube(i%6)
+	}
+	return
+}
+
+// to256 returns the nearest color on the 256 colors palette.
+func (c Color) to256() (n uint8) {
+	if c.kind != kindRGB {
+		n = c.index
+		return
+	}
+
+	if c.r == c.g && c.g == c.b {
+		switch {
+		case c.r < 8:
+			n = 16
+		case c.r > 248:
+			n = 231
+		default:
+			n = 232 + uint8((int(c.r)-8)*24/247)
+		}
+		return
+	}
+
+	scale := func(v uint8) (s uint8) {
+		s = uint8((int(v)*5 + 127) / 255)
+		return
+	}
+	n = 16 + 36*scale(c.r) + 6*scale(c.g) + scale(c.b)
+	return
+}
+
+// toBasic returns the nearest color on the 16 colors palette.
+func (c Color) toBasic() (n uint8) {
+	if c.kind == kindBasic || (c.kind == kindIndex && c.index < 16) {
+		n = c.index % 16
+		return
+	}
+
+	r, g, b := c.rgb()
+	best := -1
+	for i, v := range basicRGB {
+		dr, dg, db := int(r)-int(v[0]), int(g)-int(v[1]), int(b)-int(v[2])
+		if d := dr*dr + dg*dg + db*db; best < 0 || d < best {
+			best, n = d, uint8(i)
+		}
+	}
+	return
+}
+
+// code returns the ansi parameters to print color at level, as
+// foreground, or as background when bg is true.
+func (c Color) code(l Level, bg bool) (s string) {
+	base := 38
+	if bg {
+		base = 48
+	}
+
+	switch {
+	case l >= LevelTrueColor && c.kind == kindRGB:
+		s = fmt.Sprintf("%d;2;%d;%d;%d", base, c.r, c.g, c.b)
+	case l >= Level256 && c.kind != kindBasic:
+		s = fmt.Sprintf("%d;5;%d", base, c.to256())
+	case l >= LevelBasic:
+		n := int(c.toBasic())
+		offset := base - 8
+		if n >= 8 {
+			n, offset = n-8, offset+60
+		}
+		s = fmt.Sprintf("%d", offset+n)
+	}
+	return
+}
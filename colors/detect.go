@@ -0,0 +1,47 @@
+package colors
+
+import (
+	"io"
+	"os"
+)
+
+// Enabled tells if colors should be printed on w. NO_COLOR environment
+// variable disables colors, and FORCE_COLOR enables them, when set to
+// anything other than empty or 0. Otherwise, colors are only enabled
+// when w is a terminal, and TERM is not dumb.
+//
+//    if colors.Enabled(os.Stdout) {
+//        fmt.Print(colors.Green)
+//    }
+func Enabled(w io.Writer) (b bool) {
+	if os.Getenv("NO_COLOR") != "" {
+		return
+	}
+
+	if force := os.Getenv("FORCE_COLOR"); force != "" && force != "0" {
+		b = true
+		return
+	}
+
+	if os.Getenv("TERM") == "dumb" {
+		return
+	}
+
+	b = Terminal(w)
+	return
+}
+
+// Terminal tells if w is a terminal, whatever the environment
+// variables say.
+//
+//    if colors.Terminal(os.Stdout) {
+//        fmt.Print(colors.Hyperlink("https://go.dev", "Go"))
+//    }
+func Terminal(w io.Writer) (b bool) {
+	if f, ok := w.(*os.File); ok {
+		if info, err := f.Stat(); err == nil {
+			b = info.Mode()&os.ModeCharDevice != 0
+		}
+	}
+	return
+}
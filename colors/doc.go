@@ -1,5 +1,17 @@
 /*
 Package colors supplies a list with constants for VT100 ANSI color
-codes that can be rendered to the console.
+codes that can be rendered to the console, and detects if the console
+supports them.
+
+Beyond the 16 colors constants, styles are built with colors from the
+256 colors palette or 24-bit ones, downgraded to what the terminal
+supports:
+
+    s := colors.Style().Fg(colors.RGB(255, 128, 0)).Bold()
+    fmt.Println(s.Sprint("orange"))
+
+Width, PadLeft and PadRight ignore escape sequences, so colored text
+can be aligned, and Hyperlink makes text clickable on terminals
+supporting OSC 8.
  */
 package colors
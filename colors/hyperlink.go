@@ -0,0 +1,11 @@
+package colors
+
+// Hyperlink wraps text on an OSC 8 escape sequence, so terminals
+// supporting it open url when text is clicked. Other terminals print
+// text as is.
+//
+//    colors.Hyperlink("file:///home/me/dog_test.go", "dog_test.go:42")
+func Hyperlink(url, text string) (s string) {
+	s = "\033]8;;" + url + "\033\\" + text + "\033]8;;\033\\"
+	return
+}
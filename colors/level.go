@@ -0,0 +1,53 @@
+package colors
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	// LevelNone prints no colors at all.
+	LevelNone Level = iota
+	// LevelBasic prints the 16 colors of VT100 terminals.
+	LevelBasic
+	// Level256 prints the 256 colors palette of xterm.
+	Level256
+	// LevelTrueColor prints 24-bit colors.
+	LevelTrueColor
+)
+
+// Level tells how many colors a terminal supports.
+type Level int
+
+// DetectLevel tells the colors supported when printing on w. When
+// colors are enabled, as told by Enabled(), COLORTERM as truecolor or
+// 24bit means 24-bit colors, and TERM ending on 256color means 256
+// colors. FORCE_COLOR as 1, 2 or 3 forces each of these levels.
+//
+//    colors.Style().Level(colors.DetectLevel(os.Stderr))
+func DetectLevel(w io.Writer) (l Level) {
+	if !Enabled(w) {
+		return
+	}
+
+	switch os.Getenv("FORCE_COLOR") {
+	case "1":
+		l = LevelBasic
+	case "2":
+		l = Level256
+	case "3":
+		l = LevelTrueColor
+	default:
+		colorTerm := os.Getenv("COLORTERM")
+		switch {
+		case colorTerm == "truecolor" || colorTerm == "24bit":
+			l = LevelTrueColor
+		case strings.HasSuffix(os.Getenv("TERM"), "256color"):
+			l = Level256
+		default:
+			l = LevelBasic
+		}
+	}
+	return
+}
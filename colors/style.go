@@ -0,0 +1,162 @@
+package colors
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Styler builds a style from colors and attributes, printing text with
+// it. Colors are downgraded to the level supported by the terminal.
+type Styler interface {
+	// Fg sets the foreground color.
+	Fg(c Color) Styler
+	// Bg sets the background color.
+	Bg(c Color) Styler
+	// Bold makes text bold.
+	Bold() Styler
+	// Dim makes text faint.
+	Dim() Styler
+	// Italic makes text italic.
+	Italic() Styler
+	// Underline makes text underlined.
+	Underline() Styler
+	// Inverse swaps foreground and background colors.
+	Inverse() Styler
+	// Strikethrough crosses text out.
+	Strikethrough() Styler
+	// Level sets the colors supported, instead of detecting them.
+	Level(l Level) Styler
+	// Code returns the ansi code starting the style, empty when there
+	// are no colors.
+	Code() string
+	// Sprint formats as fmt.Sprint, wrapped on style.
+	Sprint(a ...interface{}) string
+	// Sprintf formats as fmt.Sprintf, wrapped on style.
+	Sprintf(format string, a ...interface{}) string
+}
+
+// style holds colors and attributes of a Styler.
+type style struct {
+	fg, bg *Color
+	attrs  []string
+	level  Level
+}
+
+// Style creates an empty style, for colors supported on Stdout.
+//
+//    s := colors.Style().Fg(colors.RGB(255, 128, 0)).Bold()
+//    fmt.Println(s.Sprint("orange"))
+func Style() (s Styler) {
+	s = &style{level: DetectLevel(os.Stdout)}
+	return
+}
+
+// with returns a copy of style with attribute included.
+func (s *style) with(attr string) (r Styler) {
+	c := *s
+	c.attrs = append(append([]string{}, s.attrs...), attr)
+	r = &c
+	return
+}
+
+// Fg sets the foreground color.
+func (s *style) Fg(c Color) (r Styler) {
+	cp := *s
+	cp.fg = &c
+	r = &cp
+	return
+}
+
+// Bg sets the background color.
+func (s *style) Bg(c Color) (r Styler) {
+	cp := *s
+	cp.bg = &c
+	r = &cp
+	return
+}
+
+// Bold makes text bold.
+func (s *style) Bold() (r Styler) {
+	r = s.with("1")
+	return
+}
+
+// Dim makes text faint.
+func (s *style) Dim() (r Styler) {
+	r = s.with("2")
+	return
+}
+
+// Italic makes text italic.
+func (s *style) Italic() (r Styler) {
+	r = s.with("3")
+	return
+}
+
+// Underline makes text underlined.
+func (s *style) Underline() (r Styler) {
+	r = s.with("4")
+	return
+}
+
+// Inverse swaps foreground and background colors.
+func (s *style) Inverse() (r Styler) {
+	r = s.with("7")
+	return
+}
+
+// Strikethrough crosses text out.
+func (s *style) Strikethrough() (r Styler) {
+	r = s.with("9")
+	return
+}
+
+// Level sets the colors supported, instead of detecting them.
+func (s *style) Level(l Level) (r Styler) {
+	cp := *s
+	cp.level = l
+	r = &cp
+	return
+}
+
+// Code returns the ansi code starting the style, empty when there are
+// no colors.
+func (s *style) Code() (code string) {
+	if s.level == LevelNone {
+		return
+	}
+
+	params := append([]string{}, s.attrs...)
+	if s.fg != nil {
+		params = append(params, s.fg.code(s.level, false))
+	}
+	if s.bg != nil {
+		params = append(params, s.bg.code(s.level, true))
+	}
+
+	if len(params) > 0 {
+		code = "\033[" + strings.Join(params, ";") + "m"
+	}
+	return
+}
+
+// Sprint formats as fmt.Sprint, wrapped on style.
+func (s *style) Sprint(a ...interface{}) (r string) {
+	r = s.wrap(fmt.Sprint(a...))
+	return
+}
+
+// Sprintf formats as fmt.Sprintf, wrapped on style.
+func (s *style) Sprintf(format string, a ...interface{}) (r string) {
+	r = s.wrap(fmt.Sprintf(format, a...))
+	return
+}
+
+// wrap surrounds text with style code and reset.
+func (s *style) wrap(text string) (r string) {
+	if r = text; s.Code() != "" {
+		r = s.Code() + text + Reset
+	}
+	return
+}
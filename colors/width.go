@@ -0,0 +1,73 @@
+package colors
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	// escapes matches ansi CSI sequences, as colors, and OSC sequences,
+	// as hyperlinks.
+	escapes = regexp.MustCompile("\x1b\\[[0-9;?]*[ -/]*[@-~]|\x1b\\][^\x07\x1b]*(\x07|\x1b\\\\)")
+
+	// wideRanges are the ranges of runes taking two columns.
+	wideRanges = [][2]rune{
+		{0x1100, 0x115F}, {0x2E80, 0x303E}, {0x3041, 0x33FF},
+		{0x3400, 0x4DBF}, {0x4E00, 0x9FFF}, {0xA000, 0xA4CF},
+		{0xAC00, 0xD7A3}, {0xF900, 0xFAFF}, {0xFE30, 0xFE4F},
+		{0xFF00, 0xFF60}, {0xFFE0, 0xFFE6}, {0x1F300, 0x1F64F},
+		{0x1F900, 0x1F9FF}, {0x20000, 0x3FFFD},
+	}
+)
+
+// Strip removes all escape sequences from s.
+func Strip(s string) (r string) {
+	r = escapes.ReplaceAllString(s, "")
+	return
+}
+
+// runeWidth returns the columns taken by r on a terminal.
+func runeWidth(r rune) (w int) {
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) || r == 0xFE0F {
+		return
+	}
+
+	w = 1
+	for _, rg := range wideRanges {
+		if r >= rg[0] && r <= rg[1] {
+			w = 2
+			return
+		}
+	}
+	return
+}
+
+// Width returns the columns taken by s on a terminal, ignoring escape
+// sequences, and counting wide runes as two columns.
+//
+//    colors.Width(colors.Green + "ok" + colors.Reset) // 2
+func Width(s string) (w int) {
+	for _, r := range Strip(s) {
+		w += runeWidth(r)
+	}
+	return
+}
+
+// PadRight appends spaces to s until it takes width columns.
+func PadRight(s string, width int) (r string) {
+	r = s
+	if n := width - Width(s); n > 0 {
+		r += strings.Repeat(" ", n)
+	}
+	return
+}
+
+// PadLeft prepends spaces to s until it takes width columns.
+func PadLeft(s string, width int) (r string) {
+	r = s
+	if n := width - Width(s); n > 0 {
+		r = strings.Repeat(" ", n) + r
+	}
+	return
+}
@@ -0,0 +1,12 @@
+package examples
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(bdd.Main(m))
+}
@@ -0,0 +1,98 @@
+package bdd
+
+// ordered holds the types accepting <, as used by Greater and Less.
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// Equal asserts that want and got are equal, through assert.Equal(...).
+// Both have the same type, so comparing values of different types, like
+// an int and an int64, fails to compile, instead of failing the test.
+//
+//    bdd.Equal(assert, 12, p.GetPrice())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Equal[T comparable](a Assert, want, got T, msgAndArgs ...interface{}) (b bool) {
+	b = a.Equal(want, got, msgAndArgs...)
+	return
+}
+
+// NotEqual asserts that notWant and got are not equal, through
+// assert.NotEqual(...), with both of the same type.
+//
+//    bdd.NotEqual(assert, 0, p.GetPrice())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotEqual[T comparable](a Assert, notWant, got T, msgAndArgs ...interface{}) (b bool) {
+	b = a.NotEqual(notWant, got, msgAndArgs...)
+	return
+}
+
+// Contains asserts that slice contains elem, through
+// assert.Contains(...), with elem of the type of its elements.
+//
+//    bdd.Contains(assert, p.Tags(), "sale")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Contains[T comparable](a Assert, slice []T, elem T, msgAndArgs ...interface{}) (b bool) {
+	b = a.Contains(slice, elem, msgAndArgs...)
+	return
+}
+
+// NotContains asserts that slice doesn't contain elem, through
+// assert.NotContains(...), with elem of the type of its elements.
+//
+//    bdd.NotContains(assert, p.Tags(), "sold out")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotContains[T comparable](a Assert, slice []T, elem T, msgAndArgs ...interface{}) (b bool) {
+	b = a.NotContains(slice, elem, msgAndArgs...)
+	return
+}
+
+// ElementsMatch asserts that want and got, slices of the same type,
+// have the same elements in any order, through
+// assert.ElementsMatch(...).
+//
+//    bdd.ElementsMatch(assert, []string{"new", "sale"}, p.Tags())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ElementsMatch[T comparable](a Assert, want, got []T, msgAndArgs ...interface{}) (b bool) {
+	b = a.ElementsMatch(want, got, msgAndArgs...)
+	return
+}
+
+// MapHasKey asserts that m has key, through assert.HasKey(...), with key
+// of the type of its keys.
+//
+//    bdd.MapHasKey(assert, p.Prices(), "USD")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func MapHasKey[K comparable, V any](a Assert, m map[K]V, key K, msgAndArgs ...interface{}) (b bool) {
+	b = a.HasKey(m, key, msgAndArgs...)
+	return
+}
+
+// Greater asserts that e1 is greater than e2, through
+// assert.Greater(...), with both of the same ordered type.
+//
+//    bdd.Greater(assert, p.GetPrice(), 10)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Greater[T ordered](a Assert, e1, e2 T, msgAndArgs ...interface{}) (b bool) {
+	b = a.Greater(e1, e2, msgAndArgs...)
+	return
+}
+
+// Less asserts that e1 is less than e2, through assert.Less(...), with
+// both of the same ordered type.
+//
+//    bdd.Less(assert, p.GetPrice(), 100)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Less[T ordered](a Assert, e1, e2 T, msgAndArgs ...interface{}) (b bool) {
+	b = a.Less(e1, e2, msgAndArgs...)
+	return
+}
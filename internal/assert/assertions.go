@@ -31,11 +31,11 @@ var (
 )
 
 // Comparison a custom function that returns true on success and false on failure
-type Comparison func() bool
+type Comparison = common.Comparison
 
 // PanicTestFunc defines a func that should be passed to the assert.Panics and assert.NotPanics
 // methods, and represents a simple func that takes no arguments, and returns nothing.
-type PanicTestFunc func()
+type PanicTestFunc = common.PanicTestFunc
 
 /*
 	Helper functions
@@ -212,15 +212,21 @@ func IsType(t common.Tester, expectedType interface{}, object interface{}, msgAn
 	return
 }
 
-// Equal asserts that two objects are equal.
+// Equal asserts that two objects are equal. When structs, maps,
+// slices or multi-line strings are not equal, the failure shows only
+// their differences, after DiffHeader.
 //
 //    assert.Equal(t, 123, 123, "123 and 123 should be equal")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Equal(t common.Tester, expected, actual interface{}, msgAndArgs ...interface{}) (b bool) {
 	if !ObjectsAreEqual(expected, actual) {
-		b = Fail(t, fmt.Sprintf("Not equal: %#v (expected)\n"+
-			"        != %#v (actual)", expected, actual), msgAndArgs...)
+		if d := diff(expected, actual); d != "" {
+			b = Fail(t, fmt.Sprintf("Not equal: %T\n%s", expected, d), msgAndArgs...)
+		} else {
+			b = Fail(t, fmt.Sprintf("Not equal: %#v (expected)\n"+
+				"        != %#v (actual)", expected, actual), msgAndArgs...)
+		}
 		return
 	}
 
@@ -448,36 +454,6 @@ func NotEqual(t common.Tester, expected, actual interface{}, msgAndArgs ...inter
 
 }
 
-// Contains asserts that the specified string contains the specified substring.
-//
-//    assert.Contains(t, "Hello World", "World", "But 'Hello World' does contain 'World'")
-//
-// Returns whether the assertion was successful (true) or not (false).
-func Contains(t common.Tester, s, contains string, msgAndArgs ...interface{}) bool {
-
-	if !strings.Contains(s, contains) {
-		return Fail(t, fmt.Sprintf("\"%s\" does not contain \"%s\"", s, contains), msgAndArgs...)
-	}
-
-	return true
-
-}
-
-// NotContains asserts that the specified string does NOT contain the specified substring.
-//
-//    assert.NotContains(t, "Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
-//
-// Returns whether the assertion was successful (true) or not (false).
-func NotContains(t common.Tester, s, contains string, msgAndArgs ...interface{}) bool {
-
-	if strings.Contains(s, contains) {
-		return Fail(t, fmt.Sprintf("\"%s\" should not contain \"%s\"", s, contains), msgAndArgs...)
-	}
-
-	return true
-
-}
-
 // Condition uses a Comparison to assert a complex condition.
 func Condition(t common.Tester, comp Comparison, msgAndArgs ...interface{}) bool {
 	result := comp()
@@ -525,6 +501,49 @@ func Panics(t common.Tester, f PanicTestFunc, msgAndArgs ...interface{}) bool {
 	return true
 }
 
+// PanicsWithValue asserts that the code inside the specified PanicTestFunc panics, and
+// that the recovered panic value equals the expected panic value.
+//
+//   assert.PanicsWithValue(t, "crazy", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with crazy")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsWithValue(t common.Tester, expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+
+	funcDidPanic, panicValue := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func %#v should panic\n\r\tPanic value:\t%v", f, panicValue), msgAndArgs...)
+	}
+	if !ObjectsAreEqual(expected, panicValue) {
+		return Fail(t, fmt.Sprintf("func %#v should panic with value:\t%#v\n\r\tPanic value:\t%#v", f, expected, panicValue), msgAndArgs...)
+	}
+
+	return true
+}
+
+// PanicsWithError asserts that the code inside the specified PanicTestFunc panics, and
+// that the recovered panic value is an error whose message equals errString.
+//
+//   assert.PanicsWithError(t, "crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with crazy error")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsWithError(t common.Tester, errString string, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+
+	funcDidPanic, panicValue := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func %#v should panic\n\r\tPanic value:\t%v", f, panicValue), msgAndArgs...)
+	}
+	err, ok := panicValue.(error)
+	if !ok || err.Error() != errString {
+		return Fail(t, fmt.Sprintf("func %#v should panic with error message:\t%#v\n\r\tPanic value:\t%#v", f, errString, panicValue), msgAndArgs...)
+	}
+
+	return true
+}
+
 // NotPanics asserts that the code inside the specified PanicTestFunc does NOT panic.
 //
 //   assert.NotPanics(t, func(){
@@ -556,39 +575,24 @@ func WithinDuration(t common.Tester, expected, actual time.Time, delta time.Dura
 	return true
 }
 
-// toFloat converts arg to float64 value.
-func toFloat(x interface{}) (float64, bool) {
-	var xf float64
-	xok := true
-
-	switch xn := x.(type) {
-	case uint8:
-		xf = float64(xn)
-	case uint16:
-		xf = float64(xn)
-	case uint32:
-		xf = float64(xn)
-	case uint64:
-		xf = float64(xn)
-	case int:
-		xf = float64(xn)
-	case int8:
-		xf = float64(xn)
-	case int16:
-		xf = float64(xn)
-	case int32:
-		xf = float64(xn)
-	case int64:
-		xf = float64(xn)
-	case float32:
-		xf = float64(xn)
-	case float64:
-		xf = float64(xn)
+// toFloat converts arg to float64 value, when it's an integer or a
+// float of any type.
+func toFloat(x interface{}) (xf float64, xok bool) {
+	v := reflect.ValueOf(x)
+	xok = true
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		xf = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		xf = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		xf = v.Float()
 	default:
 		xok = false
 	}
 
-	return xf, xok
+	return
 }
 
 // InDelta asserts that the two numerals are within delta of each other.
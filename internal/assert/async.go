@@ -0,0 +1,138 @@
+package assert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// recordedError is a call to Errorf kept by a recordingTester.
+type recordedError struct {
+	format string
+	args   []interface{}
+}
+
+// recordingTester is a Tester keeping failures, instead of reporting
+// them, so an attempt of assertions can be retried.
+type recordingTester struct {
+	errors []recordedError
+}
+
+// Errorf keeps the failure.
+func (r *recordingTester) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, recordedError{format, args})
+}
+
+// FailNow stops the attempt running.
+func (r *recordingTester) FailNow() {
+	panic(stopped{})
+}
+
+// attempt runs fn with assertions kept on r, until its end or the
+// first failure of a Require() assertion.
+func (r *recordingTester) attempt(fn func(common.Assert)) {
+	untilStopped(func() {
+		fn(New(r))
+	})
+}
+
+// poll checks cond every interval, until duration elapses or cond
+// returns stop, telling if it stopped and after how many attempts.
+// Condition is checked at least once, right away.
+func poll(cond Comparison, stop bool, duration, interval time.Duration) (stopped bool, attempts int) {
+	deadline := time.Now().Add(duration)
+	for {
+		attempts++
+		if stopped = cond() == stop; stopped || !time.Now().Add(interval).Before(deadline) {
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// Eventually asserts that cond returns true before timeout, checking
+// it every interval.
+//
+//    assert.Eventually(t, func() bool { return server.Ready() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Eventually(t common.Tester, cond Comparison, timeout, interval time.Duration, msgAndArgs ...interface{}) (b bool) {
+	if satisfied, attempts := poll(cond, true, timeout, interval); !satisfied {
+		b = Fail(t, fmt.Sprintf("Condition never satisfied within %v, after %d attempts", timeout, attempts), msgAndArgs...)
+		return
+	}
+
+	b = true
+	return
+}
+
+// Consistently asserts that cond keeps returning true during duration,
+// checking it every interval.
+//
+//    assert.Consistently(t, func() bool { return server.Ready() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Consistently(t common.Tester, cond Comparison, duration, interval time.Duration, msgAndArgs ...interface{}) (b bool) {
+	start := time.Now()
+	if failed, attempts := poll(cond, false, duration, interval); failed {
+		b = Fail(t, fmt.Sprintf("Condition not satisfied on attempt %d, after %v", attempts, time.Since(start).Round(time.Millisecond)), msgAndArgs...)
+		return
+	}
+
+	b = true
+	return
+}
+
+// Never asserts that cond never returns true during duration, checking
+// it every interval.
+//
+//    assert.Never(t, func() bool { return server.Crashed() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Never(t common.Tester, cond Comparison, duration, interval time.Duration, msgAndArgs ...interface{}) (b bool) {
+	start := time.Now()
+	if satisfied, attempts := poll(cond, true, duration, interval); satisfied {
+		b = Fail(t, fmt.Sprintf("Condition satisfied on attempt %d, after %v", attempts, time.Since(start).Round(time.Millisecond)), msgAndArgs...)
+		return
+	}
+
+	b = true
+	return
+}
+
+// EventuallyWith asserts that the assertions made by fn all succeed
+// before timeout, retrying fn every interval with a fresh Assert.
+// Failures of attempts are kept, and only those of the last attempt
+// are reported, after the timeout.
+//
+//    assert.EventuallyWith(t, func(assert common.Assert) {
+//        assert.Equal(3, queue.Len())
+//    }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EventuallyWith(t common.Tester, fn func(common.Assert), timeout, interval time.Duration, msgAndArgs ...interface{}) (b bool) {
+	var last *recordingTester
+	satisfied, attempts := poll(func() bool {
+		last = &recordingTester{}
+		last.attempt(fn)
+		return len(last.errors) == 0
+	}, true, timeout, interval)
+
+	if !satisfied {
+		// on Require(), every failure is reported before stopping.
+		if rt, fatal := t.(*requireTester); fatal {
+			t = rt.t
+			defer rt.stop()
+		}
+
+		b = Fail(t, fmt.Sprintf("Assertions never succeeded within %v, after %d attempts, the last failing with:", timeout, attempts), msgAndArgs...)
+		for _, e := range last.errors {
+			t.Errorf(e.format, e.args...)
+		}
+		return
+	}
+
+	b = true
+	return
+}
@@ -0,0 +1,117 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// after returns a condition becoming true once d has passed.
+func after(d time.Duration) Comparison {
+	start := time.Now()
+	return func() bool {
+		return time.Since(start) >= d
+	}
+}
+
+func TestEventually(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !Eventually(mockT, after(20*time.Millisecond), time.Second, time.Millisecond) {
+		t.Error("Eventually should return true")
+	}
+
+	if Eventually(mockT, func() bool { return false }, 20*time.Millisecond, 5*time.Millisecond) {
+		t.Error("Eventually should return false")
+	}
+	if !strings.Contains(mockT.message, "Condition never satisfied within 20ms") {
+		t.Errorf("Eventually should tell the timeout, got:\n%s", mockT.message)
+	}
+
+}
+
+func TestEventuallyChecksRightAway(t *testing.T) {
+
+	mockT := new(testing.T)
+	var calls int32
+
+	if !Eventually(mockT, func() bool { return atomic.AddInt32(&calls, 1) > 0 }, time.Millisecond, time.Hour) {
+		t.Error("Eventually should return true without waiting")
+	}
+	if calls != 1 {
+		t.Errorf("Eventually should check condition once, got %d", calls)
+	}
+
+}
+
+func TestConsistently(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !Consistently(mockT, func() bool { return true }, 20*time.Millisecond, 5*time.Millisecond) {
+		t.Error("Consistently should return true")
+	}
+
+	calls := 0
+	if Consistently(mockT, func() bool { calls++; return calls < 3 }, time.Second, time.Millisecond) {
+		t.Error("Consistently should return false")
+	}
+	if !strings.Contains(mockT.message, "Condition not satisfied on attempt 3") {
+		t.Errorf("Consistently should tell the attempt failing, got:\n%s", mockT.message)
+	}
+
+}
+
+func TestNever(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !Never(mockT, func() bool { return false }, 20*time.Millisecond, 5*time.Millisecond) {
+		t.Error("Never should return true")
+	}
+
+	if Never(mockT, after(10*time.Millisecond), time.Second, time.Millisecond) {
+		t.Error("Never should return false")
+	}
+	if !strings.Contains(mockT.message, "Condition satisfied on attempt") {
+		t.Errorf("Never should tell the attempt satisfied, got:\n%s", mockT.message)
+	}
+
+}
+
+func TestEventuallyWith(t *testing.T) {
+
+	mockT := &recordingTester{}
+	ready := after(20 * time.Millisecond)
+
+	if !EventuallyWith(mockT, func(assert common.Assert) {
+		assert.True(ready())
+	}, time.Second, time.Millisecond) {
+		t.Error("EventuallyWith should return true")
+	}
+	if len(mockT.errors) > 0 {
+		t.Errorf("EventuallyWith should not report failures of attempts, got %d", len(mockT.errors))
+	}
+
+	attempt := 0
+	if EventuallyWith(mockT, func(assert common.Assert) {
+		attempt++
+		assert.Equal(0, attempt)
+	}, 20*time.Millisecond, 5*time.Millisecond) {
+		t.Error("EventuallyWith should return false")
+	}
+	if len(mockT.errors) != 2 {
+		t.Fatalf("EventuallyWith should report the timeout and the last failure, got %d", len(mockT.errors))
+	}
+
+	last := fmt.Sprintf(mockT.errors[1].format, mockT.errors[1].args...)
+	if !strings.Contains(last, fmt.Sprintf("!= %d (actual)", attempt)) {
+		t.Errorf("EventuallyWith should report the failure of last attempt, got:\n%s", last)
+	}
+
+}
@@ -0,0 +1,381 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// element is a value of a collection, along with where it is, like
+// [2] on slices and arrays, or ["key"] on maps.
+type element struct {
+	path  string
+	value interface{}
+}
+
+// elementsOf returns the elements of a slice, array or map, with maps
+// sorted by key. It's not ok when collection is none of those.
+func elementsOf(collection interface{}) (els []element, ok bool) {
+	v := reflect.ValueOf(collection)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			els = append(els, element{fmt.Sprintf("[%d]", i), v.Index(i).Interface()})
+		}
+		ok = true
+	case reflect.Map:
+		for _, k := range sortedKeys(v) {
+			els = append(els, element{fmt.Sprintf("[%#v]", k), v.MapIndex(k).Interface()})
+		}
+		ok = true
+	}
+	return
+}
+
+// sortedKeys returns keys of map m, sorted by their Go syntax.
+func sortedKeys(m reflect.Value) (keys []reflect.Value) {
+	keys = m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return formatValue(keys[i]) < formatValue(keys[j])
+	})
+	return
+}
+
+// isList tells if collection is a slice or an array.
+func isList(collection interface{}) (b bool) {
+	k := reflect.ValueOf(collection).Kind()
+	b = k == reflect.Slice || k == reflect.Array
+	return
+}
+
+// includes tells if container holds element, returning where it was
+// found. Strings hold substrings, slices and arrays hold their
+// elements, and maps hold their values. It's not ok when container
+// is none of those.
+func includes(container, element interface{}) (path string, found, ok bool) {
+	if s, isString := container.(string); isString {
+		var sub string
+		if sub, ok = element.(string); ok {
+			found = strings.Contains(s, sub)
+		}
+		return
+	}
+
+	els, ok := elementsOf(container)
+	for _, el := range els {
+		if ObjectsAreEqual(el.value, element) {
+			path, found = el.path, true
+			return
+		}
+	}
+	return
+}
+
+// Contains asserts that the specified string contains the specified
+// substring, or that the specified slice, array or map contains the
+// specified element.
+//
+//    assert.Contains(t, "Hello World", "World", "But 'Hello World' does contain 'World'")
+//    assert.Contains(t, []string{"Hello", "World"}, "World", "But ['Hello', 'World'] does contain 'World'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Contains(t common.Tester, container, element interface{}, msgAndArgs ...interface{}) (b bool) {
+	_, found, ok := includes(container, element)
+	switch {
+	case !ok:
+		b = Fail(t, fmt.Sprintf("%#v can not contain %#v", container, element), msgAndArgs...)
+	case !found && isString(container):
+		b = Fail(t, fmt.Sprintf("\"%s\" does not contain \"%s\"", container, element), msgAndArgs...)
+	case !found:
+		b = Fail(t, fmt.Sprintf("%#v does not contain %#v", container, element), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
+
+// NotContains asserts that the specified string does NOT contain the
+// specified substring, or that the specified slice, array or map does
+// NOT contain the specified element.
+//
+//    assert.NotContains(t, "Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
+//    assert.NotContains(t, []string{"Hello", "World"}, "Earth", "But ['Hello', 'World'] does NOT contain 'Earth'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotContains(t common.Tester, container, element interface{}, msgAndArgs ...interface{}) (b bool) {
+	path, found, ok := includes(container, element)
+	switch {
+	case !ok:
+		b = Fail(t, fmt.Sprintf("%#v can not contain %#v", container, element), msgAndArgs...)
+	case found && isString(container):
+		b = Fail(t, fmt.Sprintf("\"%s\" should not contain \"%s\"", container, element), msgAndArgs...)
+	case found:
+		b = Fail(t, fmt.Sprintf("%#v should not contain %#v, found at %s", container, element, path), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
+
+// isString tells if value is a string.
+func isString(value interface{}) (b bool) {
+	_, b = value.(string)
+	return
+}
+
+// ElementsMatch asserts that the specified slices or arrays have the
+// same elements, the same number of times, regardless of their order.
+//
+//    assert.ElementsMatch(t, []int{1, 3, 2, 3}, []int{1, 3, 3, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ElementsMatch(t common.Tester, expected, actual interface{}, msgAndArgs ...interface{}) (b bool) {
+	if !isList(expected) || !isList(actual) {
+		b = Fail(t, fmt.Sprintf("Both %#v and %#v should be slices or arrays", expected, actual), msgAndArgs...)
+		return
+	}
+
+	es, _ := elementsOf(expected)
+	as, _ := elementsOf(actual)
+	matched := make([]bool, len(as))
+
+	var lines []string
+	for _, e := range es {
+		found := false
+		for i, a := range as {
+			if !matched[i] && ObjectsAreEqual(e.value, a.value) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			lines = append(lines, fmt.Sprintf("-  %s %#v", e.path, e.value))
+		}
+	}
+	for i, a := range as {
+		if !matched[i] {
+			lines = append(lines, fmt.Sprintf("+  %s %#v", a.path, a.value))
+		}
+	}
+
+	if len(lines) > 0 {
+		b = Fail(t, fmt.Sprintf("Elements differ, regardless of order: %T\n%s\n%s", expected, DiffHeader, strings.Join(lines, "\n")), msgAndArgs...)
+		return
+	}
+
+	b = true
+	return
+}
+
+// Subset asserts that every element of subset is on list. When both
+// are maps, every key of subset must be on list, with the same value.
+//
+//    assert.Subset(t, []int{1, 2, 3}, []int{3, 1})
+//    assert.Subset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Subset(t common.Tester, list, subset interface{}, msgAndArgs ...interface{}) (b bool) {
+	missing, ok := firstMissing(list, subset)
+	switch {
+	case !ok:
+		b = Fail(t, fmt.Sprintf("Both %#v and %#v should be slices, arrays or maps", list, subset), msgAndArgs...)
+	case missing != nil:
+		b = Fail(t, fmt.Sprintf("%#v does not contain %#v, at %s of subset", list, missing.value, missing.path), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
+
+// NotSubset asserts that at least one element of subset is not on
+// list. When both are maps, at least one key of subset must be missing
+// from list, or have another value.
+//
+//    assert.NotSubset(t, []int{1, 2, 3}, []int{1, 4})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotSubset(t common.Tester, list, subset interface{}, msgAndArgs ...interface{}) (b bool) {
+	missing, ok := firstMissing(list, subset)
+	switch {
+	case !ok:
+		b = Fail(t, fmt.Sprintf("Both %#v and %#v should be slices, arrays or maps", list, subset), msgAndArgs...)
+	case missing == nil:
+		b = Fail(t, fmt.Sprintf("%#v should not contain every element of %#v", list, subset), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
+
+// firstMissing returns the first element of subset not found on list,
+// or nil when every element was found. When both are maps, an element
+// is a key and value pair.
+func firstMissing(list, subset interface{}) (missing *element, ok bool) {
+	ls, subs := reflect.ValueOf(list), reflect.ValueOf(subset)
+	if ls.Kind() == reflect.Map && subs.Kind() == reflect.Map {
+		if ok = subs.Type().Key().AssignableTo(ls.Type().Key()); !ok {
+			return
+		}
+		for _, k := range sortedKeys(subs) {
+			if v := ls.MapIndex(k); !v.IsValid() || !ObjectsAreEqual(subs.MapIndex(k).Interface(), v.Interface()) {
+				missing = &element{fmt.Sprintf("[%#v]", k), subs.MapIndex(k).Interface()}
+				return
+			}
+		}
+		return
+	}
+
+	_, listOk := elementsOf(list)
+	els, subsetOk := elementsOf(subset)
+	if ok = listOk && subsetOk; !ok {
+		return
+	}
+
+	for _, el := range els {
+		if _, found, _ := includes(list, el.value); !found {
+			missing = &element{el.path, el.value}
+			return
+		}
+	}
+	return
+}
+
+// HasKey asserts that the specified map has the specified key.
+//
+//    assert.HasKey(t, map[string]int{"a": 1}, "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HasKey(t common.Tester, m, key interface{}, msgAndArgs ...interface{}) (b bool) {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map {
+		b = Fail(t, fmt.Sprintf("%#v is not a map", m), msgAndArgs...)
+		return
+	}
+
+	for _, k := range v.MapKeys() {
+		if ObjectsAreEqual(k.Interface(), key) {
+			b = true
+			return
+		}
+	}
+
+	b = Fail(t, fmt.Sprintf("%#v has no key %#v", m, key), msgAndArgs...)
+	return
+}
+
+// Unique asserts that the specified slice, array or map has no
+// repeated element.
+//
+//    assert.Unique(t, []string{"a", "b", "c"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Unique(t common.Tester, list interface{}, msgAndArgs ...interface{}) (b bool) {
+	els, ok := elementsOf(list)
+	if !ok {
+		b = Fail(t, fmt.Sprintf("%#v should be a slice, array or map", list), msgAndArgs...)
+		return
+	}
+
+	for i, el := range els {
+		for _, seen := range els[:i] {
+			if ObjectsAreEqual(seen.value, el.value) {
+				b = Fail(t, fmt.Sprintf("%#v is repeated at %s, first seen at %s", el.value, el.path, seen.path), msgAndArgs...)
+				return
+			}
+		}
+	}
+
+	b = true
+	return
+}
+
+// naturalLess tells if a comes before b, on their natural order. It's
+// not ok when they can not be compared.
+func naturalLess(a, b interface{}) (less, ok bool) {
+	c, ok := compare(a, b)
+	less = c < 0
+	return
+}
+
+// IsSorted asserts that the specified slice or array has its elements
+// in increasing order. Elements must be numbers, strings or times, use
+// IsSortedFunc for other types.
+//
+//    assert.IsSorted(t, []int{1, 2, 2, 5})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsSorted(t common.Tester, list interface{}, msgAndArgs ...interface{}) (b bool) {
+	els, _ := elementsOf(list)
+	for _, el := range els {
+		if _, ok := naturalLess(el.value, el.value); !ok {
+			b = Fail(t, fmt.Sprintf("%#v can not be ordered, at %s: use IsSortedFunc", el.value, el.path), msgAndArgs...)
+			return
+		}
+	}
+
+	b = IsSortedFunc(t, list, func(a, b interface{}) (less bool) {
+		less, _ = naturalLess(a, b)
+		return
+	}, msgAndArgs...)
+	return
+}
+
+// IsSortedFunc asserts that the specified slice or array has its
+// elements in increasing order, where less tells if a comes before b.
+//
+//    assert.IsSortedFunc(t, dogs, func(a, b interface{}) bool {
+//        return a.(Dog).Age < b.(Dog).Age
+//    })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsSortedFunc(t common.Tester, list interface{}, less func(a, b interface{}) bool, msgAndArgs ...interface{}) (b bool) {
+	if !isList(list) {
+		b = Fail(t, fmt.Sprintf("%#v should be a slice or array", list), msgAndArgs...)
+		return
+	}
+
+	els, _ := elementsOf(list)
+	for i := 1; i < len(els); i++ {
+		if less(els[i].value, els[i-1].value) {
+			b = Fail(t, fmt.Sprintf("Not sorted: %#v at %s should come before %#v at %s", els[i].value, els[i].path, els[i-1].value, els[i-1].path), msgAndArgs...)
+			return
+		}
+	}
+
+	b = true
+	return
+}
+
+// Each asserts that every element of the specified slice, array or
+// map satisfies check, telling every element that does not.
+//
+//    assert.Each(t, []int{2, 4, 6}, func(e interface{}) bool {
+//        return e.(int)%2 == 0
+//    })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Each(t common.Tester, list interface{}, check func(elem interface{}) bool, msgAndArgs ...interface{}) (b bool) {
+	els, ok := elementsOf(list)
+	if !ok {
+		b = Fail(t, fmt.Sprintf("%#v should be a slice, array or map", list), msgAndArgs...)
+		return
+	}
+
+	var failed []string
+	for _, el := range els {
+		if !check(el.value) {
+			failed = append(failed, fmt.Sprintf("  %s %#v", el.path, el.value))
+		}
+	}
+
+	if len(failed) > 0 {
+		b = Fail(t, fmt.Sprintf("Elements failed the check:\n%s", strings.Join(failed, "\n")), msgAndArgs...)
+		return
+	}
+
+	b = true
+	return
+}
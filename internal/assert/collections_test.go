@@ -0,0 +1,245 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+// A is a struct to be found on collections.
+type A struct {
+	Name, Value string
+}
+
+func TestContainsCollections(t *testing.T) {
+
+	mockT := new(testing.T)
+	list := []string{"Foo", "Bar"}
+	complexList := []*A{{"b", "c"}, {"d", "e"}}
+	simpleMap := map[interface{}]interface{}{"Foo": "Bar"}
+
+	cases := []struct {
+		container, element interface{}
+		expected           bool
+	}{
+		{list, "Bar", true},
+		{list, "Salut", false},
+		{[2]int{1, 2}, 2, true},
+		{complexList, &A{"d", "e"}, true},
+		{complexList, &A{"g", "e"}, false},
+		{simpleMap, "Bar", true},
+		{simpleMap, "Foo", false},
+	}
+
+	for _, c := range cases {
+		if Contains(mockT, c.container, c.element) != c.expected {
+			t.Errorf("Contains(%#v, %#v) should return %v", c.container, c.element, c.expected)
+		}
+		if NotContains(mockT, c.container, c.element) == c.expected {
+			t.Errorf("NotContains(%#v, %#v) should return %v", c.container, c.element, !c.expected)
+		}
+	}
+
+	if Contains(mockT, 42, 4) {
+		t.Error("Contains should return false for a container that can not contain")
+	}
+
+}
+
+func TestNotContainsLocation(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if NotContains(mockT, map[string]int{"a": 1, "b": 2}, 2) {
+		t.Error("NotContains should return false")
+	}
+	if !strings.Contains(mockT.message, `found at ["b"]`) {
+		t.Errorf("NotContains should point at the key found, got:\n%s", mockT.message)
+	}
+
+}
+
+func TestElementsMatch(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !ElementsMatch(mockT, []int{1, 3, 2, 3}, []int{3, 1, 3, 2}) {
+		t.Error("ElementsMatch should return true regardless of order")
+	}
+	if !ElementsMatch(mockT, []int{}, [0]int{}) {
+		t.Error("ElementsMatch should return true for empty lists")
+	}
+
+	if ElementsMatch(mockT, []int{1, 2, 2}, []int{2, 1, 5}) {
+		t.Error("ElementsMatch should return false")
+	}
+	if !strings.Contains(mockT.message, "-  [2] 2") || !strings.Contains(mockT.message, "+  [2] 5") {
+		t.Errorf("ElementsMatch should show the indexes of different elements, got:\n%s", mockT.message)
+	}
+
+	if ElementsMatch(mockT, map[int]int{}, []int{}) {
+		t.Error("ElementsMatch should return false for maps")
+	}
+
+}
+
+func TestSubset(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !Subset(mockT, []int{1, 2, 3}, []int{3, 1}) {
+		t.Error("Subset should return true")
+	}
+	if !Subset(mockT, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}) {
+		t.Error("Subset should return true for maps")
+	}
+	if !Subset(mockT, map[string]int{"a": 1}, []int{1}) {
+		t.Error("Subset should return true for values of map")
+	}
+
+	if Subset(mockT, []int{1, 2, 3}, []int{1, 4}) {
+		t.Error("Subset should return false")
+	}
+	if !strings.Contains(mockT.message, "4, at [1] of subset") {
+		t.Errorf("Subset should point at the missing element, got:\n%s", mockT.message)
+	}
+
+	if Subset(mockT, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3}) {
+		t.Error("Subset should return false for maps with another value")
+	}
+	if !strings.Contains(mockT.message, `at ["b"] of subset`) {
+		t.Errorf("Subset should point at the missing key, got:\n%s", mockT.message)
+	}
+
+	if Subset(mockT, map[string]int{"a": 1}, map[int]int{1: 1}) {
+		t.Error("Subset should return false for maps with another type of key")
+	}
+
+	if Subset(mockT, "abc", []string{"a"}) {
+		t.Error("Subset should return false for strings")
+	}
+
+}
+
+func TestNotSubset(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !NotSubset(mockT, []int{1, 2, 3}, []int{1, 4}) {
+		t.Error("NotSubset should return true")
+	}
+	if !NotSubset(mockT, map[string]int{"a": 1}, map[string]int{"a": 2}) {
+		t.Error("NotSubset should return true for maps with another value")
+	}
+
+	if NotSubset(mockT, []int{1, 2, 3}, []int{3, 1}) {
+		t.Error("NotSubset should return false")
+	}
+
+}
+
+func TestHasKey(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !HasKey(mockT, map[string]int{"a": 1}, "a") {
+		t.Error("HasKey should return true")
+	}
+	if HasKey(mockT, map[string]int{"a": 1}, "b") {
+		t.Error("HasKey should return false")
+	}
+	if HasKey(mockT, []string{"a"}, 0) {
+		t.Error("HasKey should return false for a slice")
+	}
+
+}
+
+func TestUnique(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !Unique(mockT, []string{"a", "b", "c"}) {
+		t.Error("Unique should return true")
+	}
+
+	if Unique(mockT, []string{"a", "b", "c", "b"}) {
+		t.Error("Unique should return false")
+	}
+	if !strings.Contains(mockT.message, `"b" is repeated at [3], first seen at [1]`) {
+		t.Errorf("Unique should point at the repeated element, got:\n%s", mockT.message)
+	}
+
+	if Unique(mockT, "abc") {
+		t.Error("Unique should return false for a string")
+	}
+
+}
+
+func TestIsSorted(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !IsSorted(mockT, []int{1, 2, 2, 5}) {
+		t.Error("IsSorted should return true")
+	}
+	if !IsSorted(mockT, []string{"a", "b"}) {
+		t.Error("IsSorted should return true for strings")
+	}
+	if !IsSorted(mockT, []float64{}) {
+		t.Error("IsSorted should return true for an empty list")
+	}
+
+	if IsSorted(mockT, []int{1, 5, 2}) {
+		t.Error("IsSorted should return false")
+	}
+	if !strings.Contains(mockT.message, "2 at [2] should come before 5 at [1]") {
+		t.Errorf("IsSorted should point at the unsorted elements, got:\n%s", mockT.message)
+	}
+
+	if IsSorted(mockT, []*A{{"a", "b"}}) {
+		t.Error("IsSorted should return false for elements without order")
+	}
+
+}
+
+func TestIsSortedFunc(t *testing.T) {
+
+	mockT := new(testing.T)
+	byName := func(a, b interface{}) bool {
+		return a.(*A).Name < b.(*A).Name
+	}
+
+	if !IsSortedFunc(mockT, []*A{{"a", "z"}, {"b", "y"}}, byName) {
+		t.Error("IsSortedFunc should return true")
+	}
+	if IsSortedFunc(mockT, []*A{{"b", "z"}, {"a", "y"}}, byName) {
+		t.Error("IsSortedFunc should return false")
+	}
+	if IsSortedFunc(mockT, map[string]*A{}, byName) {
+		t.Error("IsSortedFunc should return false for a map")
+	}
+
+}
+
+func TestEach(t *testing.T) {
+
+	mockT := &captureTester{}
+	even := func(e interface{}) bool {
+		return e.(int)%2 == 0
+	}
+
+	if !Each(mockT, []int{2, 4, 6}, even) {
+		t.Error("Each should return true")
+	}
+
+	if Each(mockT, map[string]int{"a": 2, "b": 3, "c": 5}, even) {
+		t.Error("Each should return false")
+	}
+	if !strings.Contains(mockT.message, `["b"] 3`) || !strings.Contains(mockT.message, `["c"] 5`) {
+		t.Errorf("Each should point at every failing element, got:\n%s", mockT.message)
+	}
+
+	if Each(mockT, 42, even) {
+		t.Error("Each should return false for a number")
+	}
+
+}
@@ -0,0 +1,289 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// compare returns -1, 0 or 1 when a is less than, equal to or greater
+// than b. Numbers of any kind are compared with each other, strings
+// with strings and times with times. It's not ok when a and b can not
+// be compared.
+func compare(a, b interface{}) (c int, ok bool) {
+	if at, isTime := a.(time.Time); isTime {
+		if bt, isTime := b.(time.Time); isTime {
+			c, ok = compareTimes(at, bt), true
+		}
+		return
+	}
+
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch {
+	case av.Kind() == reflect.String && bv.Kind() == reflect.String:
+		c, ok = strings.Compare(av.String(), bv.String()), true
+	case isSigned(av) && isSigned(bv):
+		c, ok = compareInts(av.Int(), bv.Int()), true
+	case isUnsigned(av) && isUnsigned(bv):
+		c, ok = compareUints(av.Uint(), bv.Uint()), true
+	case isSigned(av) && isUnsigned(bv):
+		if c, ok = -1, true; av.Int() >= 0 {
+			c = compareUints(uint64(av.Int()), bv.Uint())
+		}
+	case isUnsigned(av) && isSigned(bv):
+		if c, ok = 1, true; bv.Int() >= 0 {
+			c = compareUints(av.Uint(), uint64(bv.Int()))
+		}
+	default:
+		af, aok := toFloat(a)
+		bf, bok := toFloat(b)
+		if ok = aok && bok && !math.IsNaN(af) && !math.IsNaN(bf); ok {
+			c = compareFloats(af, bf)
+		}
+	}
+	return
+}
+
+// isSigned tells if v is a signed integer.
+func isSigned(v reflect.Value) (b bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b = true
+	}
+	return
+}
+
+// isUnsigned tells if v is an unsigned integer.
+func isUnsigned(v reflect.Value) (b bool) {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		b = true
+	}
+	return
+}
+
+// compareInts compares two signed integers.
+func compareInts(a, b int64) (c int) {
+	switch {
+	case a < b:
+		c = -1
+	case a > b:
+		c = 1
+	}
+	return
+}
+
+// compareUints compares two unsigned integers.
+func compareUints(a, b uint64) (c int) {
+	switch {
+	case a < b:
+		c = -1
+	case a > b:
+		c = 1
+	}
+	return
+}
+
+// compareFloats compares two floats, that are not NaN.
+func compareFloats(a, b float64) (c int) {
+	switch {
+	case a < b:
+		c = -1
+	case a > b:
+		c = 1
+	}
+	return
+}
+
+// compareTimes compares two instants of time.
+func compareTimes(a, b time.Time) (c int) {
+	switch {
+	case a.Before(b):
+		c = -1
+	case a.After(b):
+		c = 1
+	}
+	return
+}
+
+// operand formats a value compared, with times in their readable form
+// and other values in Go syntax.
+func operand(v interface{}) (s string) {
+	if s = fmt.Sprintf("%#v", v); isTime(v) {
+		s = fmt.Sprintf("%v", v)
+	}
+	return
+}
+
+// isTime tells if v is a time.Time.
+func isTime(v interface{}) (b bool) {
+	_, b = v.(time.Time)
+	return
+}
+
+// relate asserts that comparing a to b gives one of the results
+// accepted, failing with relation between them otherwise.
+func relate(t common.Tester, a, b interface{}, relation string, accepted []int, msgAndArgs ...interface{}) (r bool) {
+	c, ok := compare(a, b)
+	if !ok {
+		r = Fail(t, fmt.Sprintf("Can not compare %s (%T) and %s (%T)", operand(a), a, operand(b), b), msgAndArgs...)
+		return
+	}
+
+	for _, want := range accepted {
+		if r = c == want; r {
+			return
+		}
+	}
+
+	r = Fail(t, fmt.Sprintf("%s should be %s %s", operand(a), relation, operand(b)), msgAndArgs...)
+	return
+}
+
+// Greater asserts that the first value is greater than the second.
+// Values may be numbers of any kind, strings or times.
+//
+//    assert.Greater(t, 2, 1)
+//    assert.Greater(t, "b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Greater(t common.Tester, e1, e2 interface{}, msgAndArgs ...interface{}) (b bool) {
+	b = relate(t, e1, e2, "greater than", []int{1}, msgAndArgs...)
+	return
+}
+
+// GreaterOrEqual asserts that the first value is greater than or
+// equal to the second.
+//
+//    assert.GreaterOrEqual(t, 2, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GreaterOrEqual(t common.Tester, e1, e2 interface{}, msgAndArgs ...interface{}) (b bool) {
+	b = relate(t, e1, e2, "greater than or equal to", []int{1, 0}, msgAndArgs...)
+	return
+}
+
+// Less asserts that the first value is less than the second.
+//
+//    assert.Less(t, 1, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Less(t common.Tester, e1, e2 interface{}, msgAndArgs ...interface{}) (b bool) {
+	b = relate(t, e1, e2, "less than", []int{-1}, msgAndArgs...)
+	return
+}
+
+// LessOrEqual asserts that the first value is less than or equal to
+// the second.
+//
+//    assert.LessOrEqual(t, 2, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func LessOrEqual(t common.Tester, e1, e2 interface{}, msgAndArgs ...interface{}) (b bool) {
+	b = relate(t, e1, e2, "less than or equal to", []int{-1, 0}, msgAndArgs...)
+	return
+}
+
+// Between asserts that value is between min and max, both included.
+//
+//    assert.Between(t, 5, 1, 10)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Between(t common.Tester, value, min, max interface{}, msgAndArgs ...interface{}) (b bool) {
+	cmin, minOk := compare(value, min)
+	cmax, maxOk := compare(value, max)
+	switch {
+	case !minOk || !maxOk:
+		b = Fail(t, fmt.Sprintf("Can not compare %s (%T) with %s (%T) and %s (%T)", operand(value), value, operand(min), min, operand(max), max), msgAndArgs...)
+	case cmin < 0 || cmax > 0:
+		b = Fail(t, fmt.Sprintf("%s should be between %s and %s", operand(value), operand(min), operand(max)), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
+
+// Positive asserts that the specified number is greater than zero.
+//
+//    assert.Positive(t, 1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Positive(t common.Tester, e interface{}, msgAndArgs ...interface{}) (b bool) {
+	b = relate(t, e, 0, "greater than", []int{1}, msgAndArgs...)
+	return
+}
+
+// Negative asserts that the specified number is less than zero.
+//
+//    assert.Negative(t, -1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Negative(t common.Tester, e interface{}, msgAndArgs ...interface{}) (b bool) {
+	b = relate(t, e, 0, "less than", []int{-1}, msgAndArgs...)
+	return
+}
+
+// Zero asserts that the specified value is the zero value of its type,
+// like 0, "" or nil.
+//
+//    assert.Zero(t, count)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Zero(t common.Tester, e interface{}, msgAndArgs ...interface{}) (b bool) {
+	if e != nil && !reflect.ValueOf(e).IsZero() {
+		b = Fail(t, fmt.Sprintf("%s should be the zero value of %T", operand(e), e), msgAndArgs...)
+		return
+	}
+
+	b = true
+	return
+}
+
+// IsNaN asserts that the specified float is NaN.
+//
+//    assert.IsNaN(t, math.NaN())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNaN(t common.Tester, f interface{}, msgAndArgs ...interface{}) (b bool) {
+	v := reflect.ValueOf(f)
+	switch {
+	case v.Kind() != reflect.Float32 && v.Kind() != reflect.Float64:
+		b = Fail(t, fmt.Sprintf("%#v (%T) should be a float", f, f), msgAndArgs...)
+	case !math.IsNaN(v.Float()):
+		b = Fail(t, fmt.Sprintf("%#v should be NaN", f), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
+
+// IsInf asserts that the specified float is an infinity, according to
+// sign: positive if sign > 0, negative if sign < 0, either if sign == 0.
+//
+//    assert.IsInf(t, math.Inf(1), 1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsInf(t common.Tester, f interface{}, sign int, msgAndArgs ...interface{}) (b bool) {
+	infinity := "infinite"
+	switch {
+	case sign > 0:
+		infinity = "+Inf"
+	case sign < 0:
+		infinity = "-Inf"
+	}
+
+	v := reflect.ValueOf(f)
+	switch {
+	case v.Kind() != reflect.Float32 && v.Kind() != reflect.Float64:
+		b = Fail(t, fmt.Sprintf("%#v (%T) should be a float", f, f), msgAndArgs...)
+	case !math.IsInf(v.Float(), sign):
+		b = Fail(t, fmt.Sprintf("%#v should be %s", f, infinity), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
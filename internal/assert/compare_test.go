@@ -0,0 +1,182 @@
+package assert
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+
+	now := time.Now()
+	cases := []struct {
+		a, b     interface{}
+		expected int
+		ok       bool
+	}{
+		{1, 2, -1, true},
+		{int8(3), int64(3), 0, true},
+		{uint(5), 4, 1, true},
+		{-1, uint64(math.MaxUint64), -1, true},
+		{uint64(math.MaxUint64), int64(math.MaxInt64), 1, true},
+		{1.5, 1, 1, true},
+		{float32(0.5), 0.75, -1, true},
+		{time.Second, time.Minute, -1, true},
+		{"b", "a", 1, true},
+		{now, now.Add(time.Hour), -1, true},
+		{math.NaN(), 1.0, 0, false},
+		{"1", 1, 0, false},
+		{now, 1, 0, false},
+		{[]int{1}, []int{1}, 0, false},
+	}
+
+	for _, c := range cases {
+		result, ok := compare(c.a, c.b)
+		if ok != c.ok || (ok && result != c.expected) {
+			t.Errorf("compare(%#v, %#v) should return %d, %v but got %d, %v", c.a, c.b, c.expected, c.ok, result, ok)
+		}
+	}
+
+}
+
+func TestGreaterAndLess(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !Greater(mockT, 2, 1) || !Greater(mockT, "b", "a") || !Greater(mockT, 1.5, 1) {
+		t.Error("Greater should return true")
+	}
+	if Greater(mockT, 1, 1) {
+		t.Error("Greater should return false for equal values")
+	}
+	if !strings.Contains(mockT.message, "1 should be greater than 1") {
+		t.Errorf("Greater should print both operands and relation, got:\n%s", mockT.message)
+	}
+
+	if !GreaterOrEqual(mockT, 1, 1) || GreaterOrEqual(mockT, 0, 1) {
+		t.Error("GreaterOrEqual should accept only greater or equal values")
+	}
+
+	if !Less(mockT, 1, 2) || Less(mockT, 2, 2) {
+		t.Error("Less should accept only smaller values")
+	}
+
+	if !LessOrEqual(mockT, 2, 2) || LessOrEqual(mockT, 3, 2) {
+		t.Error("LessOrEqual should accept only smaller or equal values")
+	}
+	if !strings.Contains(mockT.message, "3 should be less than or equal to 2") {
+		t.Errorf("LessOrEqual should print both operands and relation, got:\n%s", mockT.message)
+	}
+
+	if Greater(mockT, "2", 1) {
+		t.Error("Greater should return false for values not comparable")
+	}
+	if !strings.Contains(mockT.message, `Can not compare "2" (string) and 1 (int)`) {
+		t.Errorf("Greater should tell values are not comparable, got:\n%s", mockT.message)
+	}
+
+}
+
+func TestCompareTimes(t *testing.T) {
+
+	mockT := &captureTester{}
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	if !Less(mockT, now, now.Add(time.Second)) {
+		t.Error("Less should return true for an earlier time")
+	}
+
+	if Greater(mockT, now, now.Add(time.Second)) {
+		t.Error("Greater should return false for an earlier time")
+	}
+	if !strings.Contains(mockT.message, "2020-01-02 03:04:05 +0000 UTC should be greater than 2020-01-02 03:04:06 +0000 UTC") {
+		t.Errorf("Greater should print times readable, got:\n%s", mockT.message)
+	}
+
+}
+
+func TestBetween(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !Between(mockT, 5, 1, 10) || !Between(mockT, 1, 1, 10) || !Between(mockT, 10.0, 1, 10) {
+		t.Error("Between should return true, including limits")
+	}
+
+	if Between(mockT, 11, 1, 10) {
+		t.Error("Between should return false")
+	}
+	if !strings.Contains(mockT.message, "11 should be between 1 and 10") {
+		t.Errorf("Between should print value and limits, got:\n%s", mockT.message)
+	}
+
+	if Between(mockT, "5", 1, 10) {
+		t.Error("Between should return false for values not comparable")
+	}
+
+}
+
+func TestPositiveAndNegative(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !Positive(mockT, 1) || !Positive(mockT, uint8(1)) || !Positive(mockT, 0.1) || !Positive(mockT, time.Second) {
+		t.Error("Positive should return true")
+	}
+	if Positive(mockT, 0) || Positive(mockT, -1.5) || Positive(mockT, "1") {
+		t.Error("Positive should return false")
+	}
+
+	if !Negative(mockT, -1) || !Negative(mockT, float32(-0.1)) {
+		t.Error("Negative should return true")
+	}
+	if Negative(mockT, 0) || Negative(mockT, uint(1)) {
+		t.Error("Negative should return false")
+	}
+
+}
+
+func TestZero(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	for _, z := range []interface{}{nil, 0, 0.0, "", false, time.Time{}, []int(nil), struct{ A int }{}} {
+		if !Zero(mockT, z) {
+			t.Errorf("Zero should return true for %#v", z)
+		}
+	}
+
+	for _, nz := range []interface{}{1, 0.1, "a", true, time.Now(), []int{}, struct{ A int }{1}} {
+		if Zero(mockT, nz) {
+			t.Errorf("Zero should return false for %#v", nz)
+		}
+	}
+
+}
+
+func TestIsNaNAndIsInf(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !IsNaN(mockT, math.NaN()) || !IsNaN(mockT, float32(math.NaN())) {
+		t.Error("IsNaN should return true")
+	}
+	if IsNaN(mockT, 1.0) || IsNaN(mockT, 1) {
+		t.Error("IsNaN should return false")
+	}
+
+	if !IsInf(mockT, math.Inf(1), 1) || !IsInf(mockT, math.Inf(-1), -1) || !IsInf(mockT, math.Inf(-1), 0) {
+		t.Error("IsInf should return true")
+	}
+	if IsInf(mockT, math.Inf(1), -1) {
+		t.Error("IsInf should return false for another sign")
+	}
+	if !strings.Contains(mockT.message, "+Inf should be -Inf") {
+		t.Errorf("IsInf should print value and sign expected, got:\n%s", mockT.message)
+	}
+	if IsInf(mockT, 1.0, 0) || IsInf(mockT, "Inf", 0) {
+		t.Error("IsInf should return false")
+	}
+
+}
@@ -0,0 +1,336 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+const (
+	// DiffHeader starts the diff of a failed equality assertion. Each
+	// line after it starts with - for expected, + for actual, or a
+	// space for unchanged lines and paths.
+	DiffHeader = "Diff (-expected +actual):"
+
+	// diffMaxDepth limits how deep values are compared, avoiding
+	// endless recursion on cyclic values.
+	diffMaxDepth = 10
+
+	// diffContext is how many unchanged lines are shown around changed
+	// ones, on diff of multi-line strings.
+	diffContext = 2
+)
+
+// differ collects lines of the difference between two values, compared
+// as opts tell.
+type differ struct {
+	lines []string
+	opts  common.EqualOptions
+}
+
+// diff returns the differences between expected and actual, as lines
+// following DiffHeader. Structs are compared field by field, maps key
+// by key, slices and arrays index by index, and multi-line strings
+// line by line. It's empty when values have different types, or are
+// simple enough to be read without a diff.
+func diff(expected, actual interface{}) (d string) {
+	if expected == nil || actual == nil {
+		return
+	}
+
+	e, a := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if e.Type() != a.Type() || !worthDiff(e) {
+		return
+	}
+
+	df := &differ{}
+	df.values("", e, a, 0)
+	d = df.report()
+	return
+}
+
+// report returns the lines collected, following DiffHeader, or empty
+// when there's no difference.
+func (df *differ) report() (d string) {
+	if len(df.lines) > 0 {
+		d = DiffHeader + "\n" + strings.Join(df.lines, "\n")
+	}
+	return
+}
+
+// worthDiff tells if v is complex enough to need a diff.
+func worthDiff(v reflect.Value) (b bool) {
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		b = true
+	case reflect.String:
+		b = strings.Contains(v.String(), "\n")
+	}
+	return
+}
+
+// formatValue formats v as Go syntax, or as missing when invalid.
+func formatValue(v reflect.Value) (s string) {
+	if s = "(missing)"; v.IsValid() {
+		s = fmt.Sprintf("%#v", v)
+	}
+	return
+}
+
+// change includes the lines of a value changed on path.
+func (df *differ) change(path string, e, a reflect.Value) {
+	if path != "" {
+		df.lines = append(df.lines, " "+path+":")
+	}
+	if e.IsValid() {
+		df.lines = append(df.lines, "-  "+formatValue(e))
+	}
+	if a.IsValid() {
+		df.lines = append(df.lines, "+  "+formatValue(a))
+	}
+}
+
+// values compares e and a on path, including lines of differences.
+func (df *differ) values(path string, e, a reflect.Value, depth int) {
+	switch {
+	case !e.IsValid() && !a.IsValid():
+		return
+	case !e.IsValid() || !a.IsValid() || e.Type() != a.Type():
+		df.change(path, e, a)
+		return
+	case depth > diffMaxDepth:
+		if formatValue(e) != formatValue(a) {
+			df.change(path, e, a)
+		}
+		return
+	}
+
+	if equal, ok := predicate(df.opts.Comparers, e.Type()); ok && e.CanInterface() && a.CanInterface() {
+		if !equal.Call([]reflect.Value{e, a})[0].Bool() {
+			df.change(path, e, a)
+		}
+		return
+	}
+
+	switch e.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if e.IsNil() || a.IsNil() {
+			if e.IsNil() != a.IsNil() {
+				df.change(path, e, a)
+			}
+			return
+		}
+		df.values(path, e.Elem(), a.Elem(), depth+1)
+
+	case reflect.Struct:
+		for i := 0; i < e.NumField(); i++ {
+			if f := e.Type().Field(i); !df.ignored(path+"."+f.Name, f) {
+				df.values(path+"."+f.Name, e.Field(i), a.Field(i), depth+1)
+			}
+		}
+
+	case reflect.Map:
+		if e.IsNil() != a.IsNil() && e.Len() == 0 && a.Len() == 0 {
+			if !df.opts.EquateEmpty {
+				df.change(path, e, a)
+			}
+			return
+		}
+
+		for _, k := range unionKeys(e, a) {
+			df.values(fmt.Sprintf("%s[%#v]", path, k), e.MapIndex(k), a.MapIndex(k), depth+1)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if e.Kind() == reflect.Slice && e.IsNil() != a.IsNil() && e.Len() == 0 && a.Len() == 0 {
+			if !df.opts.EquateEmpty {
+				df.change(path, e, a)
+			}
+			return
+		}
+		e, a = df.sorted(e), df.sorted(a)
+
+		n := e.Len()
+		if a.Len() > n {
+			n = a.Len()
+		}
+		for i := 0; i < n; i++ {
+			var ev, av reflect.Value
+			if i < e.Len() {
+				ev = e.Index(i)
+			}
+			if i < a.Len() {
+				av = a.Index(i)
+			}
+			df.values(fmt.Sprintf("%s[%d]", path, i), ev, av, depth+1)
+		}
+
+	case reflect.String:
+		if e.String() == a.String() {
+			return
+		}
+		if strings.Contains(e.String(), "\n") || strings.Contains(a.String(), "\n") {
+			df.text(path, e.String(), a.String())
+		} else {
+			df.change(path, e, a)
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if tolerance := df.opts.FloatTolerance; tolerance > 0 {
+			if !(math.Abs(e.Float()-a.Float()) <= tolerance) {
+				df.change(path, e, a)
+			}
+		} else if formatValue(e) != formatValue(a) {
+			df.change(path, e, a)
+		}
+
+	default:
+		if formatValue(e) != formatValue(a) {
+			df.change(path, e, a)
+		}
+	}
+}
+
+// ignored tells if field f, on path, is left out of comparison.
+func (df *differ) ignored(path string, f reflect.StructField) (b bool) {
+	if b = df.opts.IgnoreUnexported && f.PkgPath != ""; b {
+		return
+	}
+
+	for _, name := range df.opts.IgnoredFields {
+		if b = strings.HasSuffix(path, "."+name); b {
+			return
+		}
+	}
+	return
+}
+
+// sorted returns a copy of slice or array v, sorted by the first sorter
+// of its elements, or v itself when there's none.
+func (df *differ) sorted(v reflect.Value) (r reflect.Value) {
+	r = v
+	less, ok := predicate(df.opts.Sorters, v.Type().Elem())
+	if !ok || !v.CanInterface() {
+		return
+	}
+
+	r = reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), v.Len(), v.Len())
+	reflect.Copy(r, v)
+	sort.SliceStable(r.Interface(), func(i, j int) bool {
+		return less.Call([]reflect.Value{r.Index(i), r.Index(j)})[0].Bool()
+	})
+	return
+}
+
+// predicate returns the first of functions fns, like func(a, b T) bool,
+// taking values of type t.
+func predicate(fns []interface{}, t reflect.Type) (fn reflect.Value, ok bool) {
+	for _, f := range fns {
+		if fn = reflect.ValueOf(f); fn.Type().In(0) == t {
+			ok = true
+			return
+		}
+	}
+	return
+}
+
+// unionKeys returns keys of both maps, sorted by their Go syntax.
+func unionKeys(e, a reflect.Value) (keys []reflect.Value) {
+	seen := make(map[string]bool)
+	for _, m := range []reflect.Value{e, a} {
+		for _, k := range m.MapKeys() {
+			if s := formatValue(k); !seen[s] {
+				seen[s] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return formatValue(keys[i]) < formatValue(keys[j])
+	})
+	return
+}
+
+// text includes a unified diff of the lines of expected and actual
+// strings, with a few unchanged lines around each change.
+func (df *differ) text(path, expected, actual string) {
+	el, al := strings.Split(expected, "\n"), strings.Split(actual, "\n")
+	if len(el)*len(al) > 1000000 {
+		df.change(path, reflect.ValueOf(expected), reflect.ValueOf(actual))
+		return
+	}
+
+	// lcs[i][j] is the longest common subsequence of el[i:] and al[j:].
+	lcs := make([][]int, len(el)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(al)+1)
+	}
+	for i := len(el) - 1; i >= 0; i-- {
+		for j := len(al) - 1; j >= 0; j-- {
+			if el[i] == al[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(el) || j < len(al) {
+		switch {
+		case i < len(el) && j < len(al) && el[i] == al[j]:
+			lines = append(lines, "   "+el[i])
+			i, j = i+1, j+1
+		case i < len(el) && (j == len(al) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "-  "+el[i])
+			i++
+		default:
+			lines = append(lines, "+  "+al[j])
+			j++
+		}
+	}
+
+	if path != "" {
+		df.lines = append(df.lines, " "+path+":")
+	}
+	df.lines = append(df.lines, withContext(lines, diffContext)...)
+}
+
+// withContext keeps only changed lines, and up to n unchanged lines
+// around them, marking skipped lines with an ellipsis.
+func withContext(lines []string, n int) (r []string) {
+	keep := make([]bool, len(lines))
+	for i, l := range lines {
+		if l[0] != ' ' {
+			for k := i - n; k <= i+n; k++ {
+				if k >= 0 && k < len(lines) {
+					keep[k] = true
+				}
+			}
+		}
+	}
+
+	skipped := false
+	for i, l := range lines {
+		if keep[i] {
+			r = append(r, l)
+			skipped = false
+		} else if !skipped {
+			r = append(r, "   ...")
+			skipped = true
+		}
+	}
+	return
+}
@@ -0,0 +1,100 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type diffTesterObject struct {
+	Name  string
+	Tags  []string
+	Attrs map[string]int
+	owner *diffTesterObject
+}
+
+func TestDiff(t *testing.T) {
+
+	expected := diffTesterObject{Name: "Rex", Tags: []string{"a", "b"}, Attrs: map[string]int{"x": 1, "y": 2}, owner: &diffTesterObject{Name: "Ann"}}
+	actual := diffTesterObject{Name: "Max", Tags: []string{"a", "b", "c"}, Attrs: map[string]int{"x": 1, "z": 3}, owner: &diffTesterObject{Name: "Bob"}}
+
+	want := strings.Join([]string{
+		DiffHeader,
+		` .Name:`,
+		`-  "Rex"`,
+		`+  "Max"`,
+		` .Tags[2]:`,
+		`+  "c"`,
+		` .Attrs["y"]:`,
+		`-  2`,
+		` .Attrs["z"]:`,
+		`+  3`,
+		` .owner.Name:`,
+		`-  "Ann"`,
+		`+  "Bob"`,
+	}, "\n")
+
+	if d := diff(expected, actual); d != want {
+		t.Errorf("diff of structs should be field by field, got:\n%s", d)
+	}
+
+}
+
+func TestDiffMultilineStrings(t *testing.T) {
+
+	expected := "l1\nl2\nl3\nl4\nl5\nl6\nl7"
+	actual := "l1\nl2\nl3\nl4 changed\nl5\nl6\nl7"
+
+	want := strings.Join([]string{
+		DiffHeader,
+		`   ...`,
+		`   l2`,
+		`   l3`,
+		`-  l4`,
+		`+  l4 changed`,
+		`   l5`,
+		`   l6`,
+		`   ...`,
+	}, "\n")
+
+	if d := diff(expected, actual); d != want {
+		t.Errorf("diff of strings should be line by line, got:\n%s", d)
+	}
+
+}
+
+func TestDiffSimpleValues(t *testing.T) {
+
+	if d := diff(123, 456); d != "" {
+		t.Errorf("diff of numbers should be empty, got:\n%s", d)
+	}
+	if d := diff("Hello", "World"); d != "" {
+		t.Errorf("diff of single line strings should be empty, got:\n%s", d)
+	}
+	if d := diff([]int{1}, []string{"1"}); d != "" {
+		t.Errorf("diff of different types should be empty, got:\n%s", d)
+	}
+
+}
+
+func TestEqualWithDiff(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if Equal(mockT, []int{1, 2, 3}, []int{1, 5, 3}) {
+		t.Error("Equal should return false")
+	}
+	if !strings.Contains(mockT.message, DiffHeader) || !strings.Contains(mockT.message, "[1]:") {
+		t.Errorf("Equal should show the diff of slices, got:\n%s", mockT.message)
+	}
+
+}
+
+// captureTester is a Tester storing the last error message.
+type captureTester struct {
+	message string
+}
+
+func (c *captureTester) Errorf(format string, args ...interface{}) {
+	c.message = fmt.Sprintf(format, args...)
+}
@@ -49,6 +49,8 @@ Here is an overview of the assert functions:
 
    assert.Equal(t, expected, actual [, message [, format-args])
 
+   assert.EqualWith(t, expected, actual [, assert.IgnoreFields("CreatedAt") [, more options]])
+
    assert.NotEqual(t, notExpected, actual [, message [, format-args]])
 
    assert.True(t, actualBool [, message [, format-args]])
@@ -71,6 +73,16 @@ Here is an overview of the assert functions:
 
    assert.EqualError(t, theError, errString [, message [, format-args]])
 
+   assert.ErrorIs(t, errorObject, targetError [, message [, format-args]])
+
+   assert.NotErrorIs(t, errorObject, targetError [, message [, format-args]])
+
+   assert.ErrorAs(t, errorObject, &targetVariable [, message [, format-args]])
+
+   assert.ErrorContains(t, errorObject, substring [, message [, format-args]])
+
+   assert.ErrorMatches(t, errorObject, pattern [, message [, format-args]])
+
    assert.Implements(t, (*MyInterface)(nil), new(MyObject) [,message [, format-args]])
 
    assert.IsType(t, expectedObject, actualObject [, message [, format-args]])
@@ -79,12 +91,44 @@ Here is an overview of the assert functions:
 
    assert.NotContains(t, string, substring [, message [, format-args]])
 
+   assert.Contains(t, list, element [, message [, format-args]])
+
+   assert.NotContains(t, list, element [, message [, format-args]])
+
+   assert.ElementsMatch(t, expectedList, actualList [, message [, format-args]])
+
+   assert.Subset(t, list, subset [, message [, format-args]])
+
+   assert.NotSubset(t, list, subset [, message [, format-args]])
+
+   assert.HasKey(t, map, key [, message [, format-args]])
+
+   assert.Unique(t, list [, message [, format-args]])
+
+   assert.IsSorted(t, list [, message [, format-args]])
+
+   assert.IsSortedFunc(t, list, func(a, b interface{}) bool { return less(a, b) } [, message [, format-args]])
+
+   assert.Each(t, list, func(elem interface{}) bool { return check(elem) } [, message [, format-args]])
+
    assert.Panics(t, func(){
 
 	    // call code that should panic
 
    } [, message [, format-args]])
 
+   assert.PanicsWithValue(t, expectedValue, func(){
+
+	    // call code that should panic with expectedValue
+
+   } [, message [, format-args]])
+
+   assert.PanicsWithError(t, errString, func(){
+
+	    // call code that should panic with an error of errString
+
+   } [, message [, format-args]])
+
    assert.NotPanics(t, func(){
 
 	    // call code that should not panic
@@ -97,11 +141,55 @@ Here is an overview of the assert functions:
 
    assert.InEpsilon(t, numA, numB, epsilon, [, message [, format-args]])
 
+   assert.Greater(t, greater, smaller [, message [, format-args]])
+
+   assert.GreaterOrEqual(t, greater, smaller [, message [, format-args]])
+
+   assert.Less(t, smaller, greater [, message [, format-args]])
+
+   assert.LessOrEqual(t, smaller, greater [, message [, format-args]])
+
+   assert.Between(t, value, min, max [, message [, format-args]])
+
+   assert.Positive(t, number [, message [, format-args]])
+
+   assert.Negative(t, number [, message [, format-args]])
+
+   assert.Zero(t, actualObject [, message [, format-args]])
+
+   assert.IsNaN(t, float [, message [, format-args]])
+
+   assert.IsInf(t, float, sign [, message [, format-args]])
+
+   assert.Eventually(t, func() bool { return done() }, timeout, interval [, message [, format-args]])
+
+   assert.Consistently(t, func() bool { return healthy() }, duration, interval [, message [, format-args]])
+
+   assert.Never(t, func() bool { return crashed() }, duration, interval [, message [, format-args]])
+
+   assert.EventuallyWith(t, func(assert common.Assert) {
+
+	    // assertions retried until all succeed
+
+   }, timeout, interval [, message [, format-args]])
+
+   assert.All(t, func(assert common.Assert) {
+
+	    // assertions whose failures are reported as one
+
+   } [, message [, format-args]])
+
+   assert.That(t, actual, assert.AllOf(assert.HasLen(2), assert.Not(assert.EqualTo(nil))) [, message [, format-args]])
+
+   assert.Condition(t, func() bool { return someCheck() } [, message [, format-args]])
+
 assert package contains Assertions object. it has assertion methods.
 
 Here is an overview of the assert functions:
    assert.Equal(expected, actual [, message [, format-args])
 
+   assert.EqualWith(expected, actual [, assert.IgnoreFields("CreatedAt") [, more options]])
+
    assert.NotEqual(notExpected, actual [, message [, format-args]])
 
    assert.True(actualBool [, message [, format-args]])
@@ -124,6 +212,16 @@ Here is an overview of the assert functions:
 
    assert.EqualError(theError, errString [, message [, format-args]])
 
+   assert.ErrorIs(errorObject, targetError [, message [, format-args]])
+
+   assert.NotErrorIs(errorObject, targetError [, message [, format-args]])
+
+   assert.ErrorAs(errorObject, &targetVariable [, message [, format-args]])
+
+   assert.ErrorContains(errorObject, substring [, message [, format-args]])
+
+   assert.ErrorMatches(errorObject, pattern [, message [, format-args]])
+
    assert.Implements((*MyInterface)(nil), new(MyObject) [,message [, format-args]])
 
    assert.IsType(expectedObject, actualObject [, message [, format-args]])
@@ -132,12 +230,44 @@ Here is an overview of the assert functions:
 
    assert.NotContains(string, substring [, message [, format-args]])
 
+   assert.Contains(list, element [, message [, format-args]])
+
+   assert.NotContains(list, element [, message [, format-args]])
+
+   assert.ElementsMatch(expectedList, actualList [, message [, format-args]])
+
+   assert.Subset(list, subset [, message [, format-args]])
+
+   assert.NotSubset(list, subset [, message [, format-args]])
+
+   assert.HasKey(map, key [, message [, format-args]])
+
+   assert.Unique(list [, message [, format-args]])
+
+   assert.IsSorted(list [, message [, format-args]])
+
+   assert.IsSortedFunc(list, func(a, b interface{}) bool { return less(a, b) } [, message [, format-args]])
+
+   assert.Each(list, func(elem interface{}) bool { return check(elem) } [, message [, format-args]])
+
    assert.Panics(func(){
 
 	    // call code that should panic
 
    } [, message [, format-args]])
 
+   assert.PanicsWithValue(expectedValue, func(){
+
+	    // call code that should panic with expectedValue
+
+   } [, message [, format-args]])
+
+   assert.PanicsWithError(errString, func(){
+
+	    // call code that should panic with an error of errString
+
+   } [, message [, format-args]])
+
    assert.NotPanics(func(){
 
 	    // call code that should not panic
@@ -149,5 +279,47 @@ Here is an overview of the assert functions:
    assert.InDelta(numA, numB, delta, [, message [, format-args]])
 
    assert.InEpsilon(numA, numB, epsilon, [, message [, format-args]])
+
+   assert.Greater(greater, smaller [, message [, format-args]])
+
+   assert.GreaterOrEqual(greater, smaller [, message [, format-args]])
+
+   assert.Less(smaller, greater [, message [, format-args]])
+
+   assert.LessOrEqual(smaller, greater [, message [, format-args]])
+
+   assert.Between(value, min, max [, message [, format-args]])
+
+   assert.Positive(number [, message [, format-args]])
+
+   assert.Negative(number [, message [, format-args]])
+
+   assert.Zero(actualObject [, message [, format-args]])
+
+   assert.IsNaN(float [, message [, format-args]])
+
+   assert.IsInf(float, sign [, message [, format-args]])
+
+   assert.Eventually(func() bool { return done() }, timeout, interval [, message [, format-args]])
+
+   assert.Consistently(func() bool { return healthy() }, duration, interval [, message [, format-args]])
+
+   assert.Never(func() bool { return crashed() }, duration, interval [, message [, format-args]])
+
+   assert.EventuallyWith(func(assert common.Assert) {
+
+	    // assertions retried until all succeed
+
+   }, timeout, interval [, message [, format-args]])
+
+   assert.All(func(assert common.Assert) {
+
+	    // assertions whose failures are reported as one
+
+   } [, message [, format-args]])
+
+   assert.That(actual, assert.AllOf(assert.HasLen(2), assert.Not(assert.EqualTo(nil))) [, message [, format-args]])
+
+   assert.Condition(func() bool { return someCheck() } [, message [, format-args]])
 */
 package assert
@@ -0,0 +1,131 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// EqualOption sets an option on how EqualWith compares values.
+type EqualOption = common.EqualOption
+
+// EqualWith asserts that two objects are equal, compared field by
+// field, key by key and index by index as opts tell. The diff of
+// failures leaves out what opts ignore. Objects of different types, or
+// nil, are compared as Equal does, where only the Message option
+// applies.
+//
+//    assert.EqualWith(t, expected, user, assert.IgnoreFields("CreatedAt"), assert.EquateEmpty())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EqualWith(t common.Tester, expected, actual interface{}, opts ...EqualOption) (b bool) {
+	df := &differ{}
+	for _, opt := range opts {
+		opt(&df.opts)
+	}
+
+	if expected == nil || actual == nil || reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		b = Equal(t, expected, actual, df.opts.MsgAndArgs...)
+		return
+	}
+
+	e, a := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if df.values("", e, a, 0); len(df.lines) == 0 {
+		b = true
+		return
+	}
+
+	if worthDiff(e) {
+		b = Fail(t, fmt.Sprintf("Not equal: %T\n%s", expected, df.report()), df.opts.MsgAndArgs...)
+	} else {
+		b = Fail(t, fmt.Sprintf("Not equal: %#v (expected)\n"+
+			"        != %#v (actual)", expected, actual), df.opts.MsgAndArgs...)
+	}
+	return
+}
+
+// Message returns an option adding a message to failures of
+// EqualWith, formatted like fmt.Sprintf(format, args...), as
+// msgAndArgs does on other assertions.
+func Message(format string, args ...interface{}) (opt EqualOption) {
+	opt = func(o *common.EqualOptions) {
+		o.MsgAndArgs = append([]interface{}{format}, args...)
+	}
+	return
+}
+
+// IgnoreFields returns an option leaving out of comparison the struct
+// fields named, at any depth. Names may be paths of fields, like
+// "Owner.CreatedAt", to ignore only those under Owner.
+func IgnoreFields(names ...string) (opt EqualOption) {
+	opt = func(o *common.EqualOptions) {
+		o.IgnoredFields = append(o.IgnoredFields, names...)
+	}
+	return
+}
+
+// IgnoreUnexported returns an option leaving out of comparison every
+// unexported struct field.
+func IgnoreUnexported() (opt EqualOption) {
+	opt = func(o *common.EqualOptions) {
+		o.IgnoreUnexported = true
+	}
+	return
+}
+
+// SortSlices returns an option sorting slices and arrays of T with
+// less, a func(a, b T) bool, before comparing them, so their order
+// doesn't matter. It panics when less isn't such a function.
+//
+//    assert.SortSlices(func(a, b string) bool { return a < b })
+func SortSlices(less interface{}) (opt EqualOption) {
+	mustBePredicate("SortSlices", less)
+
+	opt = func(o *common.EqualOptions) {
+		o.Sorters = append(o.Sorters, less)
+	}
+	return
+}
+
+// FloatTolerance returns an option taking floats as equal when they
+// differ by up to tolerance.
+func FloatTolerance(tolerance float64) (opt EqualOption) {
+	opt = func(o *common.EqualOptions) {
+		o.FloatTolerance = tolerance
+	}
+	return
+}
+
+// EquateEmpty returns an option taking nil slices and maps as equal to
+// empty ones.
+func EquateEmpty() (opt EqualOption) {
+	opt = func(o *common.EqualOptions) {
+		o.EquateEmpty = true
+	}
+	return
+}
+
+// Comparer returns an option comparing values of T with equal, a
+// func(a, b T) bool, instead of field by field. It panics when equal
+// isn't such a function.
+//
+//    assert.Comparer(func(a, b time.Time) bool { return a.Equal(b) })
+func Comparer(equal interface{}) (opt EqualOption) {
+	mustBePredicate("Comparer", equal)
+
+	opt = func(o *common.EqualOptions) {
+		o.Comparers = append(o.Comparers, equal)
+	}
+	return
+}
+
+// mustBePredicate panics when fn, given to option, isn't a function
+// like func(a, b T) bool.
+func mustBePredicate(option string, fn interface{}) {
+	ft := reflect.TypeOf(fn)
+	if ft == nil || ft.Kind() != reflect.Func || ft.NumIn() != 2 || ft.In(0) != ft.In(1) ||
+		ft.NumOut() != 1 || ft.Out(0).Kind() != reflect.Bool {
+		panic(fmt.Errorf("%s needs a func(a, b T) bool, got %T", option, fn))
+	}
+}
@@ -0,0 +1,108 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type account struct {
+	Name      string
+	Balance   float64
+	Tags      []string
+	Limits    map[string]int
+	CreatedAt time.Time
+	Owner     *account
+	secret    string
+}
+
+func TestEqualWith(t *testing.T) {
+
+	mockT := &captureTester{}
+	now := time.Now()
+	expected := account{Name: "Rex", Balance: 1.0, Tags: []string{"a", "b"}, CreatedAt: now, secret: "x"}
+
+	cases := []struct {
+		actual account
+		opts   []EqualOption
+	}{
+		{account{Name: "Rex", Balance: 1.0, Tags: []string{"a", "b"}, CreatedAt: now.Add(time.Hour), secret: "x"}, []EqualOption{IgnoreFields("CreatedAt")}},
+		{account{Name: "Rex", Balance: 1.0, Tags: []string{"a", "b"}, CreatedAt: now, secret: "y"}, []EqualOption{IgnoreUnexported()}},
+		{account{Name: "Rex", Balance: 1.0, Tags: []string{"b", "a"}, CreatedAt: now, secret: "x"}, []EqualOption{SortSlices(func(a, b string) bool { return a < b })}},
+		{account{Name: "Rex", Balance: 1.0 + 1e-9, Tags: []string{"a", "b"}, CreatedAt: now, secret: "x"}, []EqualOption{FloatTolerance(1e-6)}},
+		{account{Name: "Rex", Balance: 1.0, Tags: []string{"a", "b"}, Limits: map[string]int{}, CreatedAt: now, secret: "x"}, []EqualOption{EquateEmpty()}},
+		{account{Name: "Rex", Balance: 1.0, Tags: []string{"a", "b"}, CreatedAt: now.UTC(), secret: "x"}, []EqualOption{Comparer(func(a, b time.Time) bool { return a.Equal(b) })}},
+	}
+
+	for i, c := range cases {
+		if EqualWith(mockT, expected, c.actual) {
+			t.Errorf("EqualWith should return false without options, on case %d", i)
+		}
+		if !EqualWith(mockT, expected, c.actual, c.opts...) {
+			t.Errorf("EqualWith should return true with options, on case %d, got:\n%s", i, mockT.message)
+		}
+	}
+
+}
+
+func TestEqualWithOptionsDiff(t *testing.T) {
+
+	mockT := &captureTester{}
+	expected := &account{Name: "Rex", Owner: &account{Name: "Tom", CreatedAt: time.Now()}}
+	actual := &account{Name: "Max", Owner: &account{Name: "Tom"}}
+
+	if !EqualWith(mockT, expected, actual, IgnoreFields("Name", "CreatedAt")) {
+		t.Error("EqualWith should ignore fields at any depth")
+	}
+
+	if EqualWith(mockT, expected, actual, IgnoreFields("Owner.CreatedAt")) {
+		t.Error("EqualWith should ignore only fields on path")
+	}
+	if !strings.Contains(mockT.message, " .Name:") || strings.Contains(mockT.message, "CreatedAt") {
+		t.Errorf("EqualWith should diff only fields not ignored, got:\n%s", mockT.message)
+	}
+
+	if EqualWith(mockT, 1.0, 1.5, FloatTolerance(0.1)) {
+		t.Error("EqualWith should return false beyond the tolerance")
+	}
+	if !strings.Contains(mockT.message, "Not equal: 1 (expected)") {
+		t.Errorf("EqualWith should print simple values, got:\n%s", mockT.message)
+	}
+
+	if !EqualWith(mockT, 1.0, 1.05, FloatTolerance(0.1)) || EqualWith(mockT, 1, "1") {
+		t.Error("EqualWith should compare values as options and types tell")
+	}
+
+}
+
+func TestEqualWithMessage(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if EqualWith(mockT, account{Name: "Rex"}, account{Name: "Max"}, IgnoreUnexported(), Message("account %d", 7)) {
+		t.Error("EqualWith should return false on different values")
+	}
+	if !strings.Contains(mockT.message, "account 7") {
+		t.Errorf("EqualWith should add the message to failures, got:\n%s", mockT.message)
+	}
+
+	if EqualWith(mockT, 1, "1", Message("mismatched %s", "types")) {
+		t.Error("EqualWith should return false on different types")
+	}
+	if !strings.Contains(mockT.message, "mismatched types") {
+		t.Errorf("EqualWith should keep the message on different types, got:\n%s", mockT.message)
+	}
+
+}
+
+func TestEqualOptionsPanic(t *testing.T) {
+
+	for _, fn := range []interface{}{nil, 1, func(a string) bool { return true }, func(a, b string) int { return 0 }, func(a int, b string) bool { return true }} {
+		sortPanicked, _ := didPanic(func() { SortSlices(fn) })
+		comparerPanicked, _ := didPanic(func() { Comparer(fn) })
+		if !sortPanicked || !comparerPanicked {
+			t.Errorf("options should panic with %T", fn)
+		}
+	}
+
+}
@@ -0,0 +1,172 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+const (
+	// chainMaxLength limits how many errors are unwrapped, avoiding
+	// endless loops on errors wrapping themselves.
+	chainMaxLength = 100
+)
+
+var (
+	// errorType is the reflected type of error interface.
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// errorChain returns err followed by every error it wraps, unwrapped
+// through Unwrap() of standard library or Cause() of pkg/errors.
+func errorChain(err error) (chain []error) {
+	for err != nil && len(chain) < chainMaxLength {
+		chain = append(chain, err)
+
+		var next error
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			next = e.Unwrap()
+		case interface{ Cause() error }:
+			next = e.Cause()
+		}
+		err = next
+	}
+	return
+}
+
+// describeChain returns the chain of errors wrapped by err, one per
+// line with its type and message, to be shown on failures.
+func describeChain(err error) (s string) {
+	lines := []string{"Error chain:"}
+	for i, e := range errorChain(err) {
+		lines = append(lines, fmt.Sprintf("  %d. %T: %s", i+1, e, e.Error()))
+	}
+
+	s = strings.Join(lines, "\n")
+	return
+}
+
+// chainIs tells if any error on chain of err matches target.
+func chainIs(err, target error) (b bool) {
+	for _, e := range errorChain(err) {
+		if b = errors.Is(e, target); b {
+			return
+		}
+	}
+	return
+}
+
+// ErrorIs asserts that any error on the chain wrapped by err matches
+// target, the way errors.Is() does, following pkg/errors causes too.
+//
+//   assert.ErrorIs(t, err, os.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorIs(t common.Tester, err, target error, msgAndArgs ...interface{}) (b bool) {
+	switch {
+	case err == nil:
+		b = Fail(t, fmt.Sprintf("An error matching %q is expected but got nil", target), msgAndArgs...)
+	case !chainIs(err, target):
+		b = Fail(t, fmt.Sprintf("Error should match target: %q\n%s", target, describeChain(err)), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
+
+// NotErrorIs asserts that no error on the chain wrapped by err matches
+// target.
+//
+//   assert.NotErrorIs(t, err, os.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotErrorIs(t common.Tester, err, target error, msgAndArgs ...interface{}) (b bool) {
+	if chainIs(err, target) {
+		b = Fail(t, fmt.Sprintf("Error should not match target: %q\n%s", target, describeChain(err)), msgAndArgs...)
+		return
+	}
+
+	b = true
+	return
+}
+
+// ErrorAs asserts that any error on the chain wrapped by err can be
+// assigned to target, the way errors.As() does, setting target to it.
+// Target must be a non-nil pointer to an interface or to a type
+// implementing error.
+//
+//   var pathErr *os.PathError
+//   if assert.ErrorAs(t, err, &pathErr) {
+//       assert.Equal(t, "open", pathErr.Op)
+//   }
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorAs(t common.Tester, err error, target interface{}, msgAndArgs ...interface{}) (b bool) {
+	v := reflect.ValueOf(target)
+	if target == nil || v.Kind() != reflect.Ptr || v.IsNil() {
+		b = Fail(t, fmt.Sprintf("Target must be a non-nil pointer, but was %T", target), msgAndArgs...)
+		return
+	}
+	if elem := v.Type().Elem(); elem.Kind() != reflect.Interface && !elem.Implements(errorType) {
+		b = Fail(t, fmt.Sprintf("Target must point to an interface or error, but was %T", target), msgAndArgs...)
+		return
+	}
+
+	if err == nil {
+		b = Fail(t, fmt.Sprintf("An error assignable to %v is expected but got nil", v.Type().Elem()), msgAndArgs...)
+		return
+	}
+
+	for _, e := range errorChain(err) {
+		if b = errors.As(e, target); b {
+			return
+		}
+	}
+
+	b = Fail(t, fmt.Sprintf("Error should be assignable to: %v\n%s", v.Type().Elem(), describeChain(err)), msgAndArgs...)
+	return
+}
+
+// ErrorContains asserts that err is not nil, and that its message
+// contains the specified substring.
+//
+//   assert.ErrorContains(t, err, "not found")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorContains(t common.Tester, err error, contains string, msgAndArgs ...interface{}) (b bool) {
+	switch {
+	case err == nil:
+		b = Fail(t, fmt.Sprintf("An error containing %q is expected but got nil", contains), msgAndArgs...)
+	case !strings.Contains(err.Error(), contains):
+		b = Fail(t, fmt.Sprintf("Error should contain: %q\n%s", contains, describeChain(err)), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
+
+// ErrorMatches asserts that err is not nil, and that its message
+// matches the regular expression pattern.
+//
+//   assert.ErrorMatches(t, err, `^open .*: no such file`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorMatches(t common.Tester, err error, pattern string, msgAndArgs ...interface{}) (b bool) {
+	re, rerr := regexp.Compile(pattern)
+	switch {
+	case rerr != nil:
+		b = Fail(t, fmt.Sprintf("Invalid regular expression %q: %v", pattern, rerr), msgAndArgs...)
+	case err == nil:
+		b = Fail(t, fmt.Sprintf("An error matching %q is expected but got nil", pattern), msgAndArgs...)
+	case !re.MatchString(err.Error()):
+		b = Fail(t, fmt.Sprintf("Error should match: %q\n%s", pattern, describeChain(err)), msgAndArgs...)
+	default:
+		b = true
+	}
+	return
+}
@@ -0,0 +1,150 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// customError is an error type to be found on chains.
+type customError struct {
+	code int
+}
+
+func (e *customError) Error() string {
+	return fmt.Sprintf("custom error %d", e.code)
+}
+
+func TestErrorChain(t *testing.T) {
+
+	root := errors.New("root")
+	err := pkgerrors.Wrap(fmt.Errorf("middle: %w", root), "top")
+
+	if chain := errorChain(err); len(chain) != 4 || chain[3] != root {
+		t.Errorf("errorChain should unwrap causes and wrapped errors, got %v", chain)
+	}
+
+	if chain := errorChain(nil); len(chain) != 0 {
+		t.Errorf("errorChain should be empty for nil, got %v", chain)
+	}
+
+}
+
+func TestErrorIs(t *testing.T) {
+
+	mockT := &captureTester{}
+	wrapped := pkgerrors.Wrap(fmt.Errorf("reading: %w", os.ErrNotExist), "loading")
+
+	if !ErrorIs(mockT, wrapped, os.ErrNotExist) {
+		t.Error("ErrorIs should return true for a wrapped target")
+	}
+
+	if ErrorIs(mockT, wrapped, os.ErrPermission) {
+		t.Error("ErrorIs should return false for another target")
+	}
+	if !strings.Contains(mockT.message, "Error chain:") || !strings.Contains(mockT.message, "4. *errors.errorString: file does not exist") {
+		t.Errorf("ErrorIs should show the whole chain, got:\n%s", mockT.message)
+	}
+
+	if ErrorIs(mockT, nil, os.ErrNotExist) {
+		t.Error("ErrorIs should return false for nil")
+	}
+
+}
+
+func TestNotErrorIs(t *testing.T) {
+
+	mockT := new(testing.T)
+	wrapped := fmt.Errorf("reading: %w", os.ErrNotExist)
+
+	if !NotErrorIs(mockT, wrapped, os.ErrPermission) {
+		t.Error("NotErrorIs should return true for another target")
+	}
+
+	if !NotErrorIs(mockT, nil, os.ErrNotExist) {
+		t.Error("NotErrorIs should return true for nil")
+	}
+
+	if NotErrorIs(mockT, wrapped, os.ErrNotExist) {
+		t.Error("NotErrorIs should return false for a wrapped target")
+	}
+
+}
+
+func TestErrorAs(t *testing.T) {
+
+	mockT := new(testing.T)
+	wrapped := pkgerrors.Wrap(&customError{code: 42}, "failed")
+
+	var target *customError
+	if !ErrorAs(mockT, wrapped, &target) {
+		t.Error("ErrorAs should return true for a wrapped error of target type")
+	}
+	if target == nil || target.code != 42 {
+		t.Errorf("ErrorAs should set target, got %v", target)
+	}
+
+	var pathErr *os.PathError
+	if ErrorAs(mockT, wrapped, &pathErr) {
+		t.Error("ErrorAs should return false for another type")
+	}
+
+	if ErrorAs(mockT, wrapped, target) {
+		t.Error("ErrorAs should return false for a target not pointing to an error")
+	}
+
+	if ErrorAs(mockT, wrapped, nil) {
+		t.Error("ErrorAs should return false for a nil target")
+	}
+
+	if ErrorAs(mockT, nil, &target) {
+		t.Error("ErrorAs should return false for nil")
+	}
+
+}
+
+func TestErrorContains(t *testing.T) {
+
+	mockT := new(testing.T)
+	err := errors.New("file not found")
+
+	if !ErrorContains(mockT, err, "not found") {
+		t.Error("ErrorContains should return true")
+	}
+
+	if ErrorContains(mockT, err, "denied") {
+		t.Error("ErrorContains should return false")
+	}
+
+	if ErrorContains(mockT, nil, "not found") {
+		t.Error("ErrorContains should return false for nil")
+	}
+
+}
+
+func TestErrorMatches(t *testing.T) {
+
+	mockT := new(testing.T)
+	err := errors.New("open config.yml: not found")
+
+	if !ErrorMatches(mockT, err, `^open .*\.yml`) {
+		t.Error("ErrorMatches should return true")
+	}
+
+	if ErrorMatches(mockT, err, `^read`) {
+		t.Error("ErrorMatches should return false")
+	}
+
+	if ErrorMatches(mockT, err, `(`) {
+		t.Error("ErrorMatches should return false for an invalid pattern")
+	}
+
+	if ErrorMatches(mockT, nil, `.*`) {
+		t.Error("ErrorMatches should return false for nil")
+	}
+
+}
@@ -36,6 +36,18 @@ func (a *Assertions) Equal(expected, actual interface{}, msgAndArgs ...interface
 	return Equal(a.t, expected, actual, msgAndArgs...)
 }
 
+// EqualWith asserts that two objects are equal, compared field by
+// field, key by key and index by index as opts tell. The diff of
+// failures leaves out what opts ignore. Objects of different types
+// are compared as Equal does, keeping only the Message option.
+//
+//    assert.EqualWith(expected, user, assert.IgnoreFields("CreatedAt"), assert.EquateEmpty())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) EqualWith(expected, actual interface{}, opts ...common.EqualOption) bool {
+	return EqualWith(a.t, expected, actual, opts...)
+}
+
 // Exactly asserts that two objects are equal is value and type.
 //
 //    assert.Exactly(int32(123), int64(123), "123 and 123 should NOT be equal")
@@ -122,22 +134,111 @@ func (a *Assertions) NotEqual(expected, actual interface{}, msgAndArgs ...interf
 	return NotEqual(a.t, expected, actual, msgAndArgs...)
 }
 
-// Contains asserts that the specified string contains the specified substring.
+// Contains asserts that the specified string contains the specified
+// substring, or that the specified slice, array or map contains the
+// specified element.
 //
 //    assert.Contains("Hello World", "World", "But 'Hello World' does contain 'World'")
+//    assert.Contains([]string{"Hello", "World"}, "World")
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (a *Assertions) Contains(s, contains string, msgAndArgs ...interface{}) bool {
-	return Contains(a.t, s, contains, msgAndArgs...)
+func (a *Assertions) Contains(container, element interface{}, msgAndArgs ...interface{}) bool {
+	return Contains(a.t, container, element, msgAndArgs...)
 }
 
-// NotContains asserts that the specified string does NOT contain the specified substring.
+// NotContains asserts that the specified string does NOT contain the
+// specified substring, or that the specified slice, array or map does
+// NOT contain the specified element.
 //
 //    assert.NotContains("Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
+//    assert.NotContains([]string{"Hello", "World"}, "Earth")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotContains(container, element interface{}, msgAndArgs ...interface{}) bool {
+	return NotContains(a.t, container, element, msgAndArgs...)
+}
+
+// ElementsMatch asserts that the specified slices or arrays have the
+// same elements, the same number of times, regardless of their order.
+//
+//    assert.ElementsMatch([]int{1, 3, 2, 3}, []int{1, 3, 3, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ElementsMatch(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return ElementsMatch(a.t, expected, actual, msgAndArgs...)
+}
+
+// Subset asserts that every element of subset is on list. When both
+// are maps, every key of subset must be on list, with the same value.
+//
+//    assert.Subset([]int{1, 2, 3}, []int{3, 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Subset(list, subset interface{}, msgAndArgs ...interface{}) bool {
+	return Subset(a.t, list, subset, msgAndArgs...)
+}
+
+// NotSubset asserts that at least one element of subset is not on
+// list.
+//
+//    assert.NotSubset([]int{1, 2, 3}, []int{1, 4})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotSubset(list, subset interface{}, msgAndArgs ...interface{}) bool {
+	return NotSubset(a.t, list, subset, msgAndArgs...)
+}
+
+// HasKey asserts that the specified map has the specified key.
+//
+//    assert.HasKey(map[string]int{"a": 1}, "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) HasKey(m, key interface{}, msgAndArgs ...interface{}) bool {
+	return HasKey(a.t, m, key, msgAndArgs...)
+}
+
+// Unique asserts that the specified slice, array or map has no
+// repeated element.
+//
+//    assert.Unique([]string{"a", "b", "c"})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Unique(list interface{}, msgAndArgs ...interface{}) bool {
+	return Unique(a.t, list, msgAndArgs...)
+}
+
+// IsSorted asserts that the specified slice or array of numbers or
+// strings has its elements in increasing order.
+//
+//    assert.IsSorted([]int{1, 2, 2, 5})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsSorted(list interface{}, msgAndArgs ...interface{}) bool {
+	return IsSorted(a.t, list, msgAndArgs...)
+}
+
+// IsSortedFunc asserts that the specified slice or array has its
+// elements in increasing order, where less tells if a comes before b.
+//
+//    assert.IsSortedFunc(dogs, func(a, b interface{}) bool {
+//        return a.(Dog).Age < b.(Dog).Age
+//    })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsSortedFunc(list interface{}, less func(a, b interface{}) bool, msgAndArgs ...interface{}) bool {
+	return IsSortedFunc(a.t, list, less, msgAndArgs...)
+}
+
+// Each asserts that every element of the specified slice, array or
+// map satisfies check, telling every element that does not.
+//
+//    assert.Each([]int{2, 4, 6}, func(e interface{}) bool {
+//        return e.(int)%2 == 0
+//    })
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (a *Assertions) NotContains(s, contains string, msgAndArgs ...interface{}) bool {
-	return NotContains(a.t, s, contains, msgAndArgs...)
+func (a *Assertions) Each(list interface{}, check func(elem interface{}) bool, msgAndArgs ...interface{}) bool {
+	return Each(a.t, list, check, msgAndArgs...)
 }
 
 // Uses a Comparison to assert a complex condition.
@@ -145,6 +246,19 @@ func (a *Assertions) Condition(comp Comparison, msgAndArgs ...interface{}) bool
 	return Condition(a.t, comp, msgAndArgs...)
 }
 
+// That asserts that actual is matched by matcher, failing with what
+// matcher expected and the mismatch found otherwise.
+//
+//    assert.That(user, assert.AllOf(
+//        assert.HasField("Name", assert.MatchesRegexp("^R")),
+//        assert.HasField("Pets", assert.HasLen(2)),
+//    ))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) That(actual interface{}, matcher Matcher, msgAndArgs ...interface{}) bool {
+	return That(a.t, actual, matcher, msgAndArgs...)
+}
+
 // Panics asserts that the code inside the specified PanicTestFunc panics.
 //
 //   assert.Panics(func(){
@@ -156,6 +270,30 @@ func (a *Assertions) Panics(f PanicTestFunc, msgAndArgs ...interface{}) bool {
 	return Panics(a.t, f, msgAndArgs...)
 }
 
+// PanicsWithValue asserts that the code inside the specified PanicTestFunc panics, and
+// that the recovered panic value equals the expected panic value.
+//
+//   assert.PanicsWithValue("crazy", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with crazy")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) PanicsWithValue(expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	return PanicsWithValue(a.t, expected, f, msgAndArgs...)
+}
+
+// PanicsWithError asserts that the code inside the specified PanicTestFunc panics, and
+// that the recovered panic value is an error whose message equals errString.
+//
+//   assert.PanicsWithError("crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with crazy error")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) PanicsWithError(errString string, f PanicTestFunc, msgAndArgs ...interface{}) bool {
+	return PanicsWithError(a.t, errString, f, msgAndArgs...)
+}
+
 // NotPanics asserts that the code inside the specified PanicTestFunc does NOT panic.
 //
 //   assert.NotPanics(func(){
@@ -178,20 +316,173 @@ func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Durat
 
 // InDelta asserts that the two numerals are within delta of each other.
 //
-// 	 assert.InDelta(t, math.Pi, (22 / 7.0), 0.01)
+//   assert.InDelta(math.Pi, (22 / 7.0), 0.01)
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (a *Assertions) InDelta(t common.Tester, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+func (a *Assertions) InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
 	return InDelta(a.t, expected, actual, delta, msgAndArgs...)
 }
 
 // InEpsilon asserts that expected and actual have a relative error less than epsilon
 //
 // Returns whether the assertion was successful (true) or not (false).
-func (a *Assertions) InEpsilon(t common.Tester, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+func (a *Assertions) InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
 	return InEpsilon(a.t, expected, actual, epsilon, msgAndArgs...)
 }
 
+// Greater asserts that the first value is greater than the second.
+// Values may be numbers of any kind, strings or times.
+//
+//    assert.Greater(2, 1)
+//    assert.Greater("b", "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Greater(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return Greater(a.t, e1, e2, msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the first value is greater than or
+// equal to the second.
+//
+//    assert.GreaterOrEqual(2, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) GreaterOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return GreaterOrEqual(a.t, e1, e2, msgAndArgs...)
+}
+
+// Less asserts that the first value is less than the second.
+//
+//    assert.Less(1, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Less(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return Less(a.t, e1, e2, msgAndArgs...)
+}
+
+// LessOrEqual asserts that the first value is less than or equal to
+// the second.
+//
+//    assert.LessOrEqual(2, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) LessOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	return LessOrEqual(a.t, e1, e2, msgAndArgs...)
+}
+
+// Between asserts that value is between min and max, both included.
+//
+//    assert.Between(5, 1, 10)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Between(value, min, max interface{}, msgAndArgs ...interface{}) bool {
+	return Between(a.t, value, min, max, msgAndArgs...)
+}
+
+// Positive asserts that the specified number is greater than zero.
+//
+//    assert.Positive(1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Positive(e interface{}, msgAndArgs ...interface{}) bool {
+	return Positive(a.t, e, msgAndArgs...)
+}
+
+// Negative asserts that the specified number is less than zero.
+//
+//    assert.Negative(-1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Negative(e interface{}, msgAndArgs ...interface{}) bool {
+	return Negative(a.t, e, msgAndArgs...)
+}
+
+// Zero asserts that the specified value is the zero value of its type,
+// like 0, "" or nil.
+//
+//    assert.Zero(count)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Zero(e interface{}, msgAndArgs ...interface{}) bool {
+	return Zero(a.t, e, msgAndArgs...)
+}
+
+// IsNaN asserts that the specified float is NaN.
+//
+//    assert.IsNaN(math.NaN())
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsNaN(f interface{}, msgAndArgs ...interface{}) bool {
+	return IsNaN(a.t, f, msgAndArgs...)
+}
+
+// IsInf asserts that the specified float is an infinity, according to
+// sign: positive if sign > 0, negative if sign < 0, either if sign == 0.
+//
+//    assert.IsInf(math.Inf(1), 1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsInf(f interface{}, sign int, msgAndArgs ...interface{}) bool {
+	return IsInf(a.t, f, sign, msgAndArgs...)
+}
+
+// Eventually asserts that cond returns true before timeout, checking
+// it every interval.
+//
+//    assert.Eventually(func() bool { return server.Ready() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Eventually(cond Comparison, timeout, interval time.Duration, msgAndArgs ...interface{}) bool {
+	return Eventually(a.t, cond, timeout, interval, msgAndArgs...)
+}
+
+// Consistently asserts that cond keeps returning true during duration,
+// checking it every interval.
+//
+//    assert.Consistently(func() bool { return server.Ready() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Consistently(cond Comparison, duration, interval time.Duration, msgAndArgs ...interface{}) bool {
+	return Consistently(a.t, cond, duration, interval, msgAndArgs...)
+}
+
+// Never asserts that cond never returns true during duration, checking
+// it every interval.
+//
+//    assert.Never(func() bool { return server.Crashed() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Never(cond Comparison, duration, interval time.Duration, msgAndArgs ...interface{}) bool {
+	return Never(a.t, cond, duration, interval, msgAndArgs...)
+}
+
+// EventuallyWith asserts that the assertions made by fn all succeed
+// before timeout, retrying fn every interval with a fresh Assert. Only
+// failures of the last attempt are reported, after the timeout.
+//
+//    assert.EventuallyWith(func(assert common.Assert) {
+//        assert.Equal(3, queue.Len())
+//    }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) EventuallyWith(fn func(common.Assert), timeout, interval time.Duration, msgAndArgs ...interface{}) bool {
+	return EventuallyWith(a.t, fn, timeout, interval, msgAndArgs...)
+}
+
+// All groups the assertions made by fn, reporting every failure among
+// them as a single one, with a numbered list of their messages and
+// lines. Messages name the group.
+//
+//    assert.All(func(assert common.Assert) {
+//        assert.Equal("Rex", dog.Name)
+//        assert.Equal(3, dog.Age)
+//    }, "dog fields")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) All(fn func(common.Assert), msgAndArgs ...interface{}) bool {
+	return All(a.t, fn, msgAndArgs...)
+}
+
 // NoError asserts that a function returned no error (i.e. `nil`).
 //
 //   actualObj, err := SomeFunction()
@@ -229,6 +520,59 @@ func (a *Assertions) EqualError(theError error, errString string, msgAndArgs ...
 	return EqualError(a.t, theError, errString, msgAndArgs...)
 }
 
+// ErrorIs asserts that any error on the chain wrapped by err matches
+// target, like errors.Is(), following pkg/errors causes too.
+//
+//   assert.ErrorIs(err, os.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ErrorIs(err, target error, msgAndArgs ...interface{}) bool {
+	return ErrorIs(a.t, err, target, msgAndArgs...)
+}
+
+// NotErrorIs asserts that no error on the chain wrapped by err matches
+// target.
+//
+//   assert.NotErrorIs(err, os.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotErrorIs(err, target error, msgAndArgs ...interface{}) bool {
+	return NotErrorIs(a.t, err, target, msgAndArgs...)
+}
+
+// ErrorAs asserts that any error on the chain wrapped by err can be
+// assigned to target, like errors.As(), setting target to it.
+//
+//   var pathErr *os.PathError
+//   if assert.ErrorAs(err, &pathErr) {
+//	   assert.Equal("open", pathErr.Op)
+//   }
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool {
+	return ErrorAs(a.t, err, target, msgAndArgs...)
+}
+
+// ErrorContains asserts that a function returned an error (i.e. not
+// `nil`) whose message contains the specified substring.
+//
+//   assert.ErrorContains(err, "not found")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ErrorContains(err error, contains string, msgAndArgs ...interface{}) bool {
+	return ErrorContains(a.t, err, contains, msgAndArgs...)
+}
+
+// ErrorMatches asserts that a function returned an error (i.e. not
+// `nil`) whose message matches the regular expression pattern.
+//
+//   assert.ErrorMatches(err, `^open .*: no such file`)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ErrorMatches(err error, pattern string, msgAndArgs ...interface{}) bool {
+	return ErrorMatches(a.t, err, pattern, msgAndArgs...)
+}
+
 func New(t common.Tester) *Assertions {
 	return &Assertions{
 		t: t,
@@ -203,6 +203,41 @@ func TestPanicsWrapper(t *testing.T) {
 
 }
 
+func TestPanicsWithValueWrapper(t *testing.T) {
+
+	assert := New(new(testing.T))
+
+	if !assert.PanicsWithValue("Panic!", func() {
+		panic("Panic!")
+	}) {
+		t.Error("PanicsWithValue should return true")
+	}
+
+	if assert.PanicsWithValue("Panic!", func() {
+	}) {
+		t.Error("PanicsWithValue should return false")
+	}
+
+}
+
+func TestPanicsWithErrorWrapper(t *testing.T) {
+
+	assert := New(new(testing.T))
+
+	if !assert.PanicsWithError("panic", func() {
+		panic(errors.New("panic"))
+	}) {
+		t.Error("PanicsWithError should return true")
+	}
+
+	if assert.PanicsWithError("panic", func() {
+		panic("panic")
+	}) {
+		t.Error("PanicsWithError should return false")
+	}
+
+}
+
 func TestNotPanicsWrapper(t *testing.T) {
 
 	assert := New(new(testing.T))
@@ -378,3 +413,33 @@ func TestWithinDurationWrapper(t *testing.T) {
 	assert.False(mockAssert.WithinDuration(a, b, -11*time.Second), "A 10s difference is not within a 9s time difference")
 	assert.False(mockAssert.WithinDuration(b, a, -11*time.Second), "A 10s difference is not within a 9s time difference")
 }
+
+func TestConditionWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.Condition(func() bool { return true }, "Truth") {
+		t.Error("Condition should return true")
+	}
+
+	if assert.Condition(func() bool { return false }, "Lie") {
+		t.Error("Condition should return false")
+	}
+}
+
+func TestInDeltaWrapper(t *testing.T) {
+	assert := New(t)
+	mockAssert := New(new(testing.T))
+
+	assert.True(mockAssert.InDelta(1.001, 1, 0.01), "|1.001 - 1| <= 0.01")
+	assert.True(mockAssert.InDelta(1, 2, 1), "|1 - 2| <= 1")
+	assert.False(mockAssert.InDelta(1, 2, 0.5), "Expected |1 - 2| <= 0.5 to fail")
+	assert.False(mockAssert.InDelta("", nil, 1), "Expected non numerals to fail")
+}
+
+func TestInEpsilonWrapper(t *testing.T) {
+	assert := New(t)
+	mockAssert := New(new(testing.T))
+
+	assert.True(mockAssert.InEpsilon(100, 101, 0.02), "|100 - 101| <= 100 * 0.02")
+	assert.False(mockAssert.InEpsilon(100, 110, 0.02), "Expected |100 - 110| <= 100 * 0.02 to fail")
+}
@@ -0,0 +1,82 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// groupTester reports failures to t, counting them, and stops only
+// the group at the first failure of a Require() assertion.
+type groupTester struct {
+	t      common.Tester
+	failed int
+}
+
+// Errorf reports the failure and counts it.
+func (g *groupTester) Errorf(format string, args ...interface{}) {
+	g.failed++
+	g.t.Errorf(format, args...)
+}
+
+// FailNow stops the group running.
+func (g *groupTester) FailNow() {
+	panic(stopped{})
+}
+
+// All groups the assertions made by fn, so every failure among them
+// is reported as a single one. When t is a common.GroupTester, it
+// gathers the failures, otherwise they are listed on one failure.
+//
+//    assert.All(t, func(assert common.Assert) {
+//        assert.Equal("Rex", dog.Name)
+//        assert.Equal(3, dog.Age)
+//    }, "dog fields")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func All(t common.Tester, fn func(common.Assert), msgAndArgs ...interface{}) (b bool) {
+	// on Require(), the whole group is reported before stopping.
+	if rt, fatal := t.(*requireTester); fatal {
+		if b = All(rt.t, fn, msgAndArgs...); !b {
+			rt.stop()
+		}
+		return
+	}
+
+	gt, grouped := t.(common.GroupTester)
+	if !grouped {
+		b = allListed(t, fn, msgAndArgs...)
+		return
+	}
+
+	g := &groupTester{t: t}
+	gt.Group(messageFromMsgAndArgs(msgAndArgs...), func() {
+		untilStopped(func() {
+			fn(New(g))
+		})
+	})
+
+	b = g.failed == 0
+	return
+}
+
+// allListed runs the assertions of fn, reporting their failures as a
+// single one listing them, for testers unable to group them.
+func allListed(t common.Tester, fn func(common.Assert), msgAndArgs ...interface{}) (b bool) {
+	r := &recordingTester{}
+	r.attempt(fn)
+	if len(r.errors) == 0 {
+		b = true
+		return
+	}
+
+	lines := []string{fmt.Sprintf("%d assertions failed on group:", len(r.errors))}
+	for i, e := range r.errors {
+		message := strings.Replace(fmt.Sprintf(e.format, e.args...), "\r", "", -1)
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, strings.TrimSpace(message)))
+	}
+
+	b = Fail(t, strings.Join(lines, "\n"), msgAndArgs...)
+	return
+}
@@ -0,0 +1,74 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// groupingTester is a GroupTester counting failures and groups.
+type groupingTester struct {
+	stoppingTester
+	groups []string
+}
+
+func (g *groupingTester) Group(name string, fn func()) {
+	g.groups = append(g.groups, name)
+	fn()
+}
+
+func TestAll(t *testing.T) {
+
+	mockT := &groupingTester{}
+
+	if !All(mockT, func(assert common.Assert) { assert.True(true) }) {
+		t.Error("All should return true")
+	}
+
+	reached := false
+	if All(mockT, func(assert common.Assert) {
+		assert.True(false)
+		assert.Require().True(false)
+		reached = true
+	}, "group %d", 2) {
+		t.Error("All should return false")
+	}
+	if reached || mockT.errors != 2 || mockT.stops != 0 {
+		t.Errorf("All should report every failure, stopping only the group, got %d errors and %d stops", mockT.errors, mockT.stops)
+	}
+	if len(mockT.groups) != 2 || mockT.groups[1] != "group 2" {
+		t.Errorf("All should name each group, got %v", mockT.groups)
+	}
+
+	if !mockT.stopped(func() {
+		New(mockT).Require().All(func(assert common.Assert) {
+			assert.True(false)
+			assert.True(false)
+		})
+	}) {
+		t.Error("All of Require should stop after the group")
+	}
+	if mockT.errors != 4 || mockT.stops != 1 {
+		t.Errorf("All of Require should report the group, then stop, got %d errors and %d stops", mockT.errors, mockT.stops)
+	}
+
+}
+
+func TestAllListed(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if All(mockT, func(assert common.Assert) {
+		assert.Equal(1, 2)
+		assert.Nil(1)
+	}) {
+		t.Error("All should return false")
+	}
+	for _, part := range []string{"2 assertions failed on group:", "1. ", "Not equal", "2. ", "Expected nil"} {
+		if !strings.Contains(mockT.message, part) {
+			t.Errorf("All should list failures on one message, missing %q, got:\n%s", part, mockT.message)
+		}
+	}
+
+}
@@ -0,0 +1,379 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// Matcher checks a value, describing what it expects and why a value
+// didn't match.
+type Matcher = common.Matcher
+
+// That asserts that actual is matched by matcher, failing with what
+// matcher expected and the mismatch found otherwise.
+//
+//    assert.That(t, user, assert.AllOf(
+//        assert.HasField("Name", assert.MatchesRegexp("^R")),
+//        assert.HasField("Pets", assert.HasLen(2)),
+//    ))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func That(t common.Tester, actual interface{}, matcher Matcher, msgAndArgs ...interface{}) (b bool) {
+	if !matcher.Match(actual) {
+		b = Fail(t, fmt.Sprintf("Expected: %s\n     but: %s", matcher.Describe(), matcher.DescribeMismatch(actual)), msgAndArgs...)
+		return
+	}
+
+	b = true
+	return
+}
+
+// equalTo matches values equal to expected.
+type equalTo struct {
+	expected interface{}
+}
+
+// EqualTo returns a Matcher of values equal to expected, as assert.Equal
+// considers them.
+func EqualTo(expected interface{}) (m Matcher) {
+	m = &equalTo{expected}
+	return
+}
+
+// Match tells if actual equals expected.
+func (e *equalTo) Match(actual interface{}) (b bool) {
+	b = ObjectsAreEqual(e.expected, actual)
+	return
+}
+
+// Describe tells the value expected.
+func (e *equalTo) Describe() (s string) {
+	s = fmt.Sprintf("equal to %s", operand(e.expected))
+	return
+}
+
+// DescribeMismatch tells the value found.
+func (e *equalTo) DescribeMismatch(actual interface{}) (s string) {
+	s = fmt.Sprintf("was %s", operand(actual))
+	return
+}
+
+// allOf matches values matched by every one of matchers.
+type allOf struct {
+	matchers []Matcher
+}
+
+// AllOf returns a Matcher of values matched by every one of matchers.
+func AllOf(matchers ...Matcher) (m Matcher) {
+	m = &allOf{matchers}
+	return
+}
+
+// Match tells if every matcher matches actual.
+func (a *allOf) Match(actual interface{}) (b bool) {
+	for _, m := range a.matchers {
+		if !m.Match(actual) {
+			return
+		}
+	}
+
+	b = true
+	return
+}
+
+// Describe joins what every matcher expects.
+func (a *allOf) Describe() (s string) {
+	s = joinDescriptions(a.matchers, " and ")
+	return
+}
+
+// DescribeMismatch tells why the first matcher failing didn't match.
+func (a *allOf) DescribeMismatch(actual interface{}) (s string) {
+	for _, m := range a.matchers {
+		if !m.Match(actual) {
+			s = m.DescribeMismatch(actual)
+			return
+		}
+	}
+	return
+}
+
+// anyOf matches values matched by one of matchers, at least.
+type anyOf struct {
+	matchers []Matcher
+}
+
+// AnyOf returns a Matcher of values matched by one of matchers, at
+// least.
+func AnyOf(matchers ...Matcher) (m Matcher) {
+	m = &anyOf{matchers}
+	return
+}
+
+// Match tells if some matcher matches actual.
+func (a *anyOf) Match(actual interface{}) (b bool) {
+	for _, m := range a.matchers {
+		if b = m.Match(actual); b {
+			return
+		}
+	}
+	return
+}
+
+// Describe joins what every matcher expects.
+func (a *anyOf) Describe() (s string) {
+	s = joinDescriptions(a.matchers, " or ")
+	return
+}
+
+// DescribeMismatch tells why every matcher didn't match.
+func (a *anyOf) DescribeMismatch(actual interface{}) (s string) {
+	mismatches := make([]string, 0, len(a.matchers))
+	for _, m := range a.matchers {
+		mismatches = append(mismatches, m.DescribeMismatch(actual))
+	}
+
+	s = strings.Join(mismatches, " and ")
+	return
+}
+
+// joinDescriptions returns the descriptions of matchers, joined by sep
+// between parentheses.
+func joinDescriptions(matchers []Matcher, sep string) (s string) {
+	descriptions := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		descriptions = append(descriptions, m.Describe())
+	}
+
+	s = fmt.Sprintf("(%s)", strings.Join(descriptions, sep))
+	return
+}
+
+// not matches values not matched by matcher.
+type not struct {
+	matcher Matcher
+}
+
+// Not returns a Matcher of values not matched by matcher.
+func Not(matcher Matcher) (m Matcher) {
+	m = &not{matcher}
+	return
+}
+
+// Match tells if matcher doesn't match actual.
+func (n *not) Match(actual interface{}) (b bool) {
+	b = !n.matcher.Match(actual)
+	return
+}
+
+// Describe negates what matcher expects.
+func (n *not) Describe() (s string) {
+	s = fmt.Sprintf("not %s", n.matcher.Describe())
+	return
+}
+
+// DescribeMismatch tells the value found.
+func (n *not) DescribeMismatch(actual interface{}) (s string) {
+	s = fmt.Sprintf("was %s", operand(actual))
+	return
+}
+
+// hasField matches structs, or pointers to them, whose field named has
+// a value matched by matcher.
+type hasField struct {
+	name    string
+	matcher Matcher
+}
+
+// HasField returns a Matcher of structs, or pointers to them, having
+// an exported field named name, whose value is matched by matcher.
+func HasField(name string, matcher Matcher) (m Matcher) {
+	m = &hasField{name, matcher}
+	return
+}
+
+// field returns the value of field named on actual, or why it has no
+// such field.
+func (h *hasField) field(actual interface{}) (value interface{}, missing string) {
+	v := reflect.ValueOf(actual)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		missing = fmt.Sprintf("was %s, not a struct", operand(actual))
+		return
+	}
+
+	f := v.FieldByName(h.name)
+	switch {
+	case !f.IsValid():
+		missing = fmt.Sprintf("%s has no field %s", v.Type(), h.name)
+	case !f.CanInterface():
+		missing = fmt.Sprintf("field %s of %s is unexported", h.name, v.Type())
+	default:
+		value = f.Interface()
+	}
+	return
+}
+
+// Match tells if actual has the field, matched by matcher.
+func (h *hasField) Match(actual interface{}) (b bool) {
+	if value, missing := h.field(actual); missing == "" {
+		b = h.matcher.Match(value)
+	}
+	return
+}
+
+// Describe tells the field and what matcher expects of it.
+func (h *hasField) Describe() (s string) {
+	s = fmt.Sprintf("has field %s %s", h.name, h.matcher.Describe())
+	return
+}
+
+// DescribeMismatch tells why the field is missing, or why its value
+// didn't match.
+func (h *hasField) DescribeMismatch(actual interface{}) (s string) {
+	value, missing := h.field(actual)
+	if s = missing; s == "" {
+		s = fmt.Sprintf("field %s %s", h.name, h.matcher.DescribeMismatch(value))
+	}
+	return
+}
+
+// hasLen matches values with length expected.
+type hasLen struct {
+	length int
+}
+
+// HasLen returns a Matcher of values, accepted by len(), with length
+// expected.
+func HasLen(length int) (m Matcher) {
+	m = &hasLen{length}
+	return
+}
+
+// Match tells if actual has the length expected.
+func (h *hasLen) Match(actual interface{}) (b bool) {
+	ok, l := getLen(actual)
+	b = ok && l == h.length
+	return
+}
+
+// Describe tells the length expected.
+func (h *hasLen) Describe() (s string) {
+	s = fmt.Sprintf("has length %d", h.length)
+	return
+}
+
+// DescribeMismatch tells the length found.
+func (h *hasLen) DescribeMismatch(actual interface{}) (s string) {
+	if ok, l := getLen(actual); ok {
+		s = fmt.Sprintf("had length %d", l)
+	} else {
+		s = fmt.Sprintf("was %s, without length", operand(actual))
+	}
+	return
+}
+
+// matchesRegexp matches texts matching a regular expression.
+type matchesRegexp struct {
+	pattern string
+	re      *regexp.Regexp
+	err     error
+}
+
+// MatchesRegexp returns a Matcher of strings, byte slices, errors or
+// fmt.Stringers whose text matches the regular expression pattern. An
+// invalid pattern matches nothing.
+func MatchesRegexp(pattern string) (m Matcher) {
+	re, err := regexp.Compile(pattern)
+	m = &matchesRegexp{pattern, re, err}
+	return
+}
+
+// text returns the text of actual, telling if it has one.
+func text(actual interface{}) (s string, ok bool) {
+	ok = true
+	switch v := actual.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case error:
+		s = v.Error()
+	case fmt.Stringer:
+		s = v.String()
+	default:
+		ok = false
+	}
+	return
+}
+
+// Match tells if the text of actual matches the regular expression.
+func (r *matchesRegexp) Match(actual interface{}) (b bool) {
+	if s, ok := text(actual); ok && r.err == nil {
+		b = r.re.MatchString(s)
+	}
+	return
+}
+
+// Describe tells the regular expression.
+func (r *matchesRegexp) Describe() (s string) {
+	s = fmt.Sprintf("matches %q", r.pattern)
+	return
+}
+
+// DescribeMismatch tells the text found, or why it couldn't match.
+func (r *matchesRegexp) DescribeMismatch(actual interface{}) (s string) {
+	t, ok := text(actual)
+	switch {
+	case r.err != nil:
+		s = fmt.Sprintf("regular expression is invalid: %v", r.err)
+	case !ok:
+		s = fmt.Sprintf("was %s, without text", operand(actual))
+	default:
+		s = fmt.Sprintf("was %q", t)
+	}
+	return
+}
+
+// approximately matches numbers within delta of expected.
+type approximately struct {
+	expected, delta float64
+}
+
+// Approximately returns a Matcher of numbers, of any kind, within delta
+// of expected.
+func Approximately(expected, delta float64) (m Matcher) {
+	m = &approximately{expected, delta}
+	return
+}
+
+// Match tells if actual is a number within delta of expected.
+func (a *approximately) Match(actual interface{}) (b bool) {
+	if f, ok := toFloat(actual); ok {
+		b = f >= a.expected-a.delta && f <= a.expected+a.delta
+	}
+	return
+}
+
+// Describe tells the number expected and delta allowed.
+func (a *approximately) Describe() (s string) {
+	s = fmt.Sprintf("within %v of %v", a.delta, a.expected)
+	return
+}
+
+// DescribeMismatch tells the number found and its difference.
+func (a *approximately) DescribeMismatch(actual interface{}) (s string) {
+	if f, ok := toFloat(actual); ok {
+		s = fmt.Sprintf("was %v, differing by %v", f, f-a.expected)
+	} else {
+		s = fmt.Sprintf("was %s, not a number", operand(actual))
+	}
+	return
+}
@@ -0,0 +1,78 @@
+package assert
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestThat(t *testing.T) {
+
+	mockT := &captureTester{}
+
+	if !That(mockT, 3, EqualTo(3)) {
+		t.Error("That should return true")
+	}
+
+	if That(mockT, 3, EqualTo(4)) {
+		t.Error("That should return false")
+	}
+	for _, part := range []string{"Expected: equal to 4", "but: was 3"} {
+		if !strings.Contains(mockT.message, part) {
+			t.Errorf("That should describe the matcher and the mismatch, missing %q, got:\n%s", part, mockT.message)
+		}
+	}
+
+}
+
+func TestMatchers(t *testing.T) {
+
+	dog := &struct {
+		Name string
+		Tags []string
+		age  int
+	}{"Rex", []string{"good"}, 3}
+
+	cases := []struct {
+		matcher     Matcher
+		actual      interface{}
+		matches     bool
+		description string
+		mismatch    string
+	}{
+		{AllOf(HasLen(3), MatchesRegexp("^R")), "Rex", true, `(has length 3 and matches "^R")`, ""},
+		{AllOf(HasLen(3), MatchesRegexp("^T")), "Rex", false, "", `was "Rex"`},
+		{AnyOf(EqualTo(1), EqualTo(2)), 2, true, "(equal to 1 or equal to 2)", ""},
+		{AnyOf(EqualTo(1), EqualTo(2)), 3, false, "", "was 3 and was 3"},
+		{Not(EqualTo(1)), 2, true, "not equal to 1", ""},
+		{Not(EqualTo(1)), 1, false, "", "was 1"},
+		{HasField("Name", EqualTo("Rex")), dog, true, `has field Name equal to "Rex"`, ""},
+		{HasField("Name", EqualTo("Tom")), *dog, false, "", `field Name was "Rex"`},
+		{HasField("Owner", EqualTo("Tom")), dog, false, "", "has no field Owner"},
+		{HasField("age", EqualTo(3)), dog, false, "", "field age of struct"},
+		{HasField("Name", EqualTo("Rex")), "Rex", false, "", `was "Rex", not a struct`},
+		{HasLen(1), dog.Tags, true, "has length 1", ""},
+		{HasLen(2), dog.Tags, false, "", "had length 1"},
+		{HasLen(2), 2, false, "", "was 2, without length"},
+		{MatchesRegexp(`^not \w+`), errors.New("not found"), true, `matches "^not \\w+"`, ""},
+		{MatchesRegexp("^a"), []byte("b"), false, "", `was "b"`},
+		{MatchesRegexp("("), "(", false, "", "regular expression is invalid"},
+		{MatchesRegexp("1"), 1, false, "", "was 1, without text"},
+		{Approximately(1, 0.1), float32(1.05), true, "within 0.1 of 1", ""},
+		{Approximately(1, 0.5), 2, false, "", "was 2, differing by 1"},
+		{Approximately(1, 0.5), "1", false, "", `was "1", not a number`},
+	}
+
+	for _, c := range cases {
+		if c.matcher.Match(c.actual) != c.matches {
+			t.Errorf("%s should return %v on %#v", c.matcher.Describe(), c.matches, c.actual)
+		}
+		if c.description != "" && c.matcher.Describe() != c.description {
+			t.Errorf("matcher should be described as %q, got %q", c.description, c.matcher.Describe())
+		}
+		if mismatch := c.matcher.DescribeMismatch(c.actual); c.mismatch != "" && !strings.Contains(mismatch, c.mismatch) {
+			t.Errorf("%s should describe mismatch of %#v with %q, got %q", c.matcher.Describe(), c.actual, c.mismatch, mismatch)
+		}
+	}
+
+}
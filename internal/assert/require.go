@@ -0,0 +1,64 @@
+package assert
+
+import (
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// stopped is panicked by testers of attempts and groups, to stop them
+// at the first failure of a Require() assertion.
+type stopped struct{}
+
+// untilStopped runs fn until its end, or until it's stopped.
+func untilStopped(fn func()) {
+	defer func() {
+		if p := recover(); p != nil {
+			if _, ok := p.(stopped); !ok {
+				panic(p)
+			}
+		}
+	}()
+
+	fn()
+}
+
+// requireTester reports failures to t, then stops the current
+// verification right away.
+type requireTester struct {
+	t common.Tester
+}
+
+// Errorf reports the failure and stops the verification, through
+// FailNow() of t.
+func (r *requireTester) Errorf(format string, args ...interface{}) {
+	r.t.Errorf(format, args...)
+	r.stop()
+}
+
+// stop stops the verification, through FailNow() of t. When t can't
+// stop it, that's reported as another failure, and it goes on.
+func (r *requireTester) stop() {
+	ft, ok := r.t.(common.FatalTester)
+	if !ok {
+		r.t.Errorf("Require() can't stop the verification: tester has no FailNow()")
+		return
+	}
+	ft.FailNow()
+}
+
+// Require returns assertions with the same methods, that stop the
+// current verification on the first failure, instead of returning
+// false. Stopping goes through FailNow() of the tester, so, like
+// t.FailNow(), it must be called from the goroutine running the
+// verification: on other goroutines, it crashes the test binary.
+//
+//    assert.Require().NotNil(dog)
+//    assert.Equal("Rex", dog.Name)
+func (a *Assertions) Require() (r common.Assert) {
+	if _, fatal := a.t.(*requireTester); fatal {
+		r = a
+		return
+	}
+
+	r = New(&requireTester{a.t})
+	return
+}
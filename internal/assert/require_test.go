@@ -0,0 +1,97 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ddsgok/bdd/internal/common"
+)
+
+// stoppingTester is a FatalTester counting failures and stops, where
+// stopping panics like the spec package does.
+type stoppingTester struct {
+	errors, stops int
+}
+
+func (s *stoppingTester) Errorf(format string, args ...interface{}) {
+	s.errors++
+}
+
+func (s *stoppingTester) FailNow() {
+	s.stops++
+	panic(s)
+}
+
+// stopped runs fn, telling if it was stopped by FailNow of s.
+func (s *stoppingTester) stopped(fn func()) (b bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if b = r == s; !b {
+				panic(r)
+			}
+		}
+	}()
+
+	fn()
+	return
+}
+
+func TestRequire(t *testing.T) {
+
+	mockT := &stoppingTester{}
+	require := New(mockT).Require()
+
+	if mockT.stopped(func() { require.NotNil(1) }) {
+		t.Error("Require should not stop on success")
+	}
+
+	reached := false
+	if !mockT.stopped(func() {
+		require.NotNil(nil)
+		reached = true
+	}) {
+		t.Error("Require should stop on failure")
+	}
+	if reached || mockT.errors != 1 || mockT.stops != 1 {
+		t.Errorf("Require should report the failure once, then stop, got %d errors and %d stops", mockT.errors, mockT.stops)
+	}
+
+	if require.Require() != require {
+		t.Error("Require of Require should be the same assertions")
+	}
+
+}
+
+func TestRequireEventuallyWith(t *testing.T) {
+
+	mockT := &stoppingTester{}
+	require := New(mockT).Require()
+
+	attempts := 0
+	if !mockT.stopped(func() {
+		require.EventuallyWith(func(assert common.Assert) {
+			attempts++
+			assert.Require().True(false)
+			assert.True(false)
+		}, 10*time.Millisecond, time.Millisecond)
+	}) {
+		t.Error("EventuallyWith of Require should stop on failure")
+	}
+	if attempts == 0 || mockT.errors != 2 || mockT.stops != 1 {
+		t.Errorf("EventuallyWith of Require should report the timeout and the failure stopping the last attempt, got %d errors and %d stops", mockT.errors, mockT.stops)
+	}
+
+}
+
+func TestRequireWithoutFailNow(t *testing.T) {
+
+	mockT := &captureTester{}
+	if New(mockT).Require().True(false) {
+		t.Error("Require should fail when the Tester can not stop")
+	}
+	if !strings.Contains(mockT.message, "tester has no FailNow()") {
+		t.Errorf("Require should report it can not stop, got %q", mockT.message)
+	}
+
+}
@@ -24,6 +24,17 @@ type Assert interface {
 	// Returns whether the assertion was successful (true) or not (false).
 	Equal(expected, actual interface{}, msgAndArgs ...interface{}) bool
 
+	// EqualWith asserts that two objects are equal, compared field by
+	// field, key by key and index by index as opts tell. The diff of
+	// failures leaves out what opts ignore. Objects of different types
+	// are compared as Equal does, ignoring every option but
+	// bdd.Message(...), that replaces msgAndArgs.
+	//
+	//    assert.EqualWith(expected, user, bdd.IgnoreFields("CreatedAt"), bdd.EquateEmpty(), bdd.Message("user %d", id))
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	EqualWith(expected, actual interface{}, opts ...EqualOption) bool
+
 	// Exactly asserts that two objects are equal is value and type.
 	//
 	//    assert.Exactly(int32(123), int64(123), "123 and 123 should NOT be equal")
@@ -91,25 +102,111 @@ type Assert interface {
 	// Returns whether the assertion was successful (true) or not (false).
 	NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) bool
 
-	// Contains asserts that the specified string contains the specified substring.
+	// Contains asserts that the specified string contains the specified
+	// substring, or that the specified slice, array or map contains the
+	// specified element.
 	//
 	//    assert.Contains("Hello World", "World", "But 'Hello World' does contain 'World'")
+	//    assert.Contains([]string{"Hello", "World"}, "World")
 	//
 	// Returns whether the assertion was successful (true) or not (false).
-	Contains(s, contains string, msgAndArgs ...interface{}) bool
+	Contains(container, element interface{}, msgAndArgs ...interface{}) bool
 
-	// NotContains asserts that the specified string does NOT contain the specified substring.
+	// NotContains asserts that the specified string does NOT contain the
+	// specified substring, or that the specified slice, array or map
+	// does NOT contain the specified element.
 	//
 	//    assert.NotContains("Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
+	//    assert.NotContains([]string{"Hello", "World"}, "Earth")
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	NotContains(container, element interface{}, msgAndArgs ...interface{}) bool
+
+	// ElementsMatch asserts that the specified slices or arrays have the
+	// same elements, the same number of times, regardless of their order.
+	//
+	//    assert.ElementsMatch([]int{1, 3, 2, 3}, []int{1, 3, 3, 2})
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	ElementsMatch(expected, actual interface{}, msgAndArgs ...interface{}) bool
+
+	// Subset asserts that every element of subset is on list. When both
+	// are maps, every key of subset must be on list, with the same value.
+	//
+	//    assert.Subset([]int{1, 2, 3}, []int{3, 1})
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Subset(list, subset interface{}, msgAndArgs ...interface{}) bool
+
+	// NotSubset asserts that at least one element of subset is not on
+	// list.
+	//
+	//    assert.NotSubset([]int{1, 2, 3}, []int{1, 4})
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	NotSubset(list, subset interface{}, msgAndArgs ...interface{}) bool
+
+	// HasKey asserts that the specified map has the specified key.
+	//
+	//    assert.HasKey(map[string]int{"a": 1}, "a")
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	HasKey(m, key interface{}, msgAndArgs ...interface{}) bool
+
+	// Unique asserts that the specified slice, array or map has no
+	// repeated element.
+	//
+	//    assert.Unique([]string{"a", "b", "c"})
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Unique(list interface{}, msgAndArgs ...interface{}) bool
+
+	// IsSorted asserts that the specified slice or array of numbers or
+	// strings has its elements in increasing order.
+	//
+	//    assert.IsSorted([]int{1, 2, 2, 5})
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	IsSorted(list interface{}, msgAndArgs ...interface{}) bool
+
+	// IsSortedFunc asserts that the specified slice or array has its
+	// elements in increasing order, where less tells if a comes before b.
+	//
+	//    assert.IsSortedFunc(dogs, func(a, b interface{}) bool {
+	//        return a.(Dog).Age < b.(Dog).Age
+	//    })
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	IsSortedFunc(list interface{}, less func(a, b interface{}) bool, msgAndArgs ...interface{}) bool
+
+	// Each asserts that every element of the specified slice, array or
+	// map satisfies check, telling every element that does not.
+	//
+	//    assert.Each([]int{2, 4, 6}, func(e interface{}) bool {
+	//        return e.(int)%2 == 0
+	//    })
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Each(list interface{}, check func(elem interface{}) bool, msgAndArgs ...interface{}) bool
+
+	// Condition uses a Comparison to assert a complex condition.
+	//
+	//    assert.Condition(func() bool { return len(list) < limit }, "list should be under limit")
 	//
 	// Returns whether the assertion was successful (true) or not (false).
-	NotContains(s, contains string, msgAndArgs ...interface{}) bool
+	Condition(comp Comparison, msgAndArgs ...interface{}) bool
 
-	// TODO Implement Condition()
-	// Uses a Comparison to assert a complex condition.
-	///Condition(comp Comparison, msgAndArgs ...interface{}) bool
+	// That asserts that actual is matched by matcher, failing with what
+	// matcher expected and the mismatch found otherwise.
+	//
+	//    assert.That(user, bdd.AllOf(
+	//        bdd.HasField("Name", bdd.MatchesRegexp("^R")),
+	//        bdd.HasField("Pets", bdd.HasLen(2)),
+	//    ))
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	That(actual interface{}, matcher Matcher, msgAndArgs ...interface{}) bool
 
-	// TODO Implement Panics()
 	// Panics asserts that the code inside the specified PanicTestFunc panics.
 	//
 	//   assert.Panics(func(){
@@ -117,9 +214,30 @@ type Assert interface {
 	//   }, "Calling GoCrazy() should panic")
 	//
 	// Returns whether the assertion was successful (true) or not (false).
-	//Panics(f PanicTestFunc, msgAndArgs ...interface{}) bool
+	Panics(f PanicTestFunc, msgAndArgs ...interface{}) bool
+
+	// PanicsWithValue asserts that the code inside the specified
+	// PanicTestFunc panics, and that the recovered panic value equals
+	// the expected one.
+	//
+	//   assert.PanicsWithValue("crazy", func(){
+	//     GoCrazy()
+	//   }, "Calling GoCrazy() should panic with crazy")
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	PanicsWithValue(expected interface{}, f PanicTestFunc, msgAndArgs ...interface{}) bool
+
+	// PanicsWithError asserts that the code inside the specified
+	// PanicTestFunc panics, and that the recovered panic value is an
+	// error whose message equals errString.
+	//
+	//   assert.PanicsWithError("crazy error", func(){
+	//     GoCrazy()
+	//   }, "Calling GoCrazy() should panic with crazy error")
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	PanicsWithError(errString string, f PanicTestFunc, msgAndArgs ...interface{}) bool
 
-	// TODO Implement NotPanics()
 	// NotPanics asserts that the code inside the specified PanicTestFunc does NOT panic.
 	//
 	//   assert.NotPanics(func(){
@@ -127,7 +245,7 @@ type Assert interface {
 	//   }, "Calling RemainCalm() should NOT panic")
 	//
 	// Returns whether the assertion was successful (true) or not (false).
-	//NotPanics(f PanicTestFunc, msgAndArgs ...interface{}) bool
+	NotPanics(f PanicTestFunc, msgAndArgs ...interface{}) bool
 
 	// WithinDuration asserts that the two times are within duration delta of each other.
 	//
@@ -136,19 +254,153 @@ type Assert interface {
 	// Returns whether the assertion was successful (true) or not (false).
 	WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) bool
 
-	// TODO Implement InDelta()
 	// InDelta asserts that the two numerals are within delta of each other.
 	//
-	// 	 assert.InDelta(t, math.Pi, (22 / 7.0), 0.01)
+	//   assert.InDelta(math.Pi, (22 / 7.0), 0.01)
 	//
 	// Returns whether the assertion was successful (true) or not (false).
-	//InDelta(t Tester, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool
+	InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool
 
-	// TODO Implement InEpsilon()
-	// InEpsilon asserts that expected and actual have a relative error less than epsilon
+	// InEpsilon asserts that expected and actual have a relative error less than epsilon.
+	//
+	//   assert.InEpsilon(100, 101, 0.02)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool
+
+	// Greater asserts that the first value is greater than the second.
+	// Values may be numbers of any kind, strings or times.
+	//
+	//    assert.Greater(2, 1)
+	//    assert.Greater("b", "a")
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Greater(e1, e2 interface{}, msgAndArgs ...interface{}) bool
+
+	// GreaterOrEqual asserts that the first value is greater than or
+	// equal to the second.
+	//
+	//    assert.GreaterOrEqual(2, 2)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	GreaterOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) bool
+
+	// Less asserts that the first value is less than the second.
+	//
+	//    assert.Less(1, 2)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Less(e1, e2 interface{}, msgAndArgs ...interface{}) bool
+
+	// LessOrEqual asserts that the first value is less than or equal to
+	// the second.
+	//
+	//    assert.LessOrEqual(2, 2)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	LessOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) bool
+
+	// Between asserts that value is between min and max, both included.
+	//
+	//    assert.Between(5, 1, 10)
 	//
 	// Returns whether the assertion was successful (true) or not (false).
-	//InEpsilon(t Tester, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool
+	Between(value, min, max interface{}, msgAndArgs ...interface{}) bool
+
+	// Positive asserts that the specified number is greater than zero.
+	//
+	//    assert.Positive(1)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Positive(e interface{}, msgAndArgs ...interface{}) bool
+
+	// Negative asserts that the specified number is less than zero.
+	//
+	//    assert.Negative(-1)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Negative(e interface{}, msgAndArgs ...interface{}) bool
+
+	// Zero asserts that the specified value is the zero value of its type,
+	// like 0, "" or nil.
+	//
+	//    assert.Zero(count)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Zero(e interface{}, msgAndArgs ...interface{}) bool
+
+	// IsNaN asserts that the specified float is NaN.
+	//
+	//    assert.IsNaN(math.NaN())
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	IsNaN(f interface{}, msgAndArgs ...interface{}) bool
+
+	// IsInf asserts that the specified float is an infinity, according to
+	// sign: positive if sign > 0, negative if sign < 0, either if sign == 0.
+	//
+	//    assert.IsInf(math.Inf(1), 1)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	IsInf(f interface{}, sign int, msgAndArgs ...interface{}) bool
+
+	// Eventually asserts that cond returns true before timeout, checking
+	// it every interval.
+	//
+	//    assert.Eventually(func() bool { return server.Ready() }, time.Second, 10*time.Millisecond)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Eventually(cond Comparison, timeout, interval time.Duration, msgAndArgs ...interface{}) bool
+
+	// Consistently asserts that cond keeps returning true during duration,
+	// checking it every interval.
+	//
+	//    assert.Consistently(func() bool { return server.Ready() }, time.Second, 10*time.Millisecond)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Consistently(cond Comparison, duration, interval time.Duration, msgAndArgs ...interface{}) bool
+
+	// Never asserts that cond never returns true during duration, checking
+	// it every interval.
+	//
+	//    assert.Never(func() bool { return server.Crashed() }, time.Second, 10*time.Millisecond)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	Never(cond Comparison, duration, interval time.Duration, msgAndArgs ...interface{}) bool
+
+	// EventuallyWith asserts that the assertions made by fn all succeed
+	// before timeout, retrying fn every interval with a fresh Assert. Only
+	// failures of the last attempt are reported, after the timeout.
+	//
+	//    assert.EventuallyWith(func(assert Assert) {
+	//        assert.Equal(3, queue.Len())
+	//    }, time.Second, 10*time.Millisecond)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	EventuallyWith(fn func(Assert), timeout, interval time.Duration, msgAndArgs ...interface{}) bool
+
+	// All groups the assertions made by fn, reporting every failure
+	// among them as a single one, with a numbered list of their messages
+	// and lines. Messages name the group.
+	//
+	//    assert.All(func(assert Assert) {
+	//        assert.Equal("Rex", dog.Name)
+	//        assert.Equal(3, dog.Age)
+	//    }, "dog fields")
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	All(fn func(Assert), msgAndArgs ...interface{}) bool
+
+	// Require returns assertions with the same methods, that stop the
+	// current verification on the first failure, instead of returning
+	// false. Remaining verifications still run. Like t.FailNow(), it
+	// must be used on the goroutine running the verification: failing
+	// on other goroutines crashes the test binary.
+	//
+	//    require := assert.Require()
+	//    require.NotNil(dog)
+	//    assert.Equal("Rex", dog.Name)
+	Require() Assert
 
 	// NoError asserts that a function returned no error (i.e. `nil`).
 	//
@@ -180,13 +432,105 @@ type Assert interface {
 	//
 	// Returns whether the assertion was successful (true) or not (false).
 	EqualError(theError error, errString string, msgAndArgs ...interface{}) bool
+
+	// ErrorIs asserts that any error on the chain wrapped by err matches
+	// target, like errors.Is(), following pkg/errors causes too.
+	//
+	//   assert.ErrorIs(err, os.ErrNotExist)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	ErrorIs(err, target error, msgAndArgs ...interface{}) bool
+
+	// NotErrorIs asserts that no error on the chain wrapped by err
+	// matches target.
+	//
+	//   assert.NotErrorIs(err, os.ErrNotExist)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	NotErrorIs(err, target error, msgAndArgs ...interface{}) bool
+
+	// ErrorAs asserts that any error on the chain wrapped by err can be
+	// assigned to target, like errors.As(), setting target to it.
+	//
+	//   var pathErr *os.PathError
+	//   if assert.ErrorAs(err, &pathErr) {
+	//	   assert.Equal("open", pathErr.Op)
+	//   }
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool
+
+	// ErrorContains asserts that a function returned an error (i.e. not
+	// `nil`) whose message contains the specified substring.
+	//
+	//   assert.ErrorContains(err, "not found")
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	ErrorContains(err error, contains string, msgAndArgs ...interface{}) bool
+
+	// ErrorMatches asserts that a function returned an error (i.e. not
+	// `nil`) whose message matches the regular expression pattern.
+	//
+	//   assert.ErrorMatches(err, `^open .*: no such file`)
+	//
+	// Returns whether the assertion was successful (true) or not (false).
+	ErrorMatches(err error, pattern string, msgAndArgs ...interface{}) bool
+}
+
+// Comparison is a custom function that returns true on success and
+// false on failure, used by Condition.
+type Comparison func() bool
+
+// PanicTestFunc is a func that takes no arguments and returns nothing,
+// used by Panics and its variants to check what the code panicked.
+type PanicTestFunc func()
+
+// Matcher checks a value for assert.That, describing what it expects,
+// completing "Expected: ...", and why a value didn't match, completing
+// "but: ...", when Match returns false.
+type Matcher interface {
+	Match(actual interface{}) bool
+	Describe() string
+	DescribeMismatch(actual interface{}) string
 }
 
+// EqualOptions tells how assert.EqualWith compares values. Fields are
+// ignored by name, or by path of names, like "Owner.CreatedAt". Sorters
+// and Comparers hold functions like func(a, b T) bool, sorting slices
+// of T before comparing them, and telling if values of T are equal.
+// MsgAndArgs is added to failures, as on other assertions.
+type EqualOptions struct {
+	IgnoredFields    []string
+	IgnoreUnexported bool
+	Sorters          []interface{}
+	FloatTolerance   float64
+	EquateEmpty      bool
+	Comparers        []interface{}
+	MsgAndArgs       []interface{}
+}
+
+// EqualOption sets an option on how assert.EqualWith compares values.
+type EqualOption func(o *EqualOptions)
+
 // Tester is an interface wrapper around *testing.T
 type Tester interface {
 	Errorf(format string, args ...interface{})
 }
 
+// FatalTester is a Tester able to stop the current verification right
+// away, like *testing.T does.
+type FatalTester interface {
+	Tester
+	FailNow()
+}
+
+// GroupTester is a Tester able to gather the failures reported while
+// fn runs, reporting them as a single one, for the group named.
+type GroupTester interface {
+	Tester
+	Group(name string, fn func())
+}
+
 // Golden allows to retrieve information and update golden files.
 type Golden interface {
 	Get(string) interface{}
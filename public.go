@@ -1,7 +1,11 @@
 package bdd
 
 import (
+	"testing"
+
+	"github.com/ddsgok/bdd/internal/assert"
 	"github.com/ddsgok/bdd/internal/common"
+	"github.com/ddsgok/bdd/spec"
 )
 
 // Arguments defines a set of arguments, to run on Given, When or It sentences.
@@ -16,6 +20,24 @@ type It func(title string, args ...interface{})
 // Assert defines the action of asserting things during test.
 type Assert = common.Assert
 
+// Comparison defines a function returning true when a complex
+// condition holds, to be checked with assert.Condition(...).
+type Comparison = common.Comparison
+
+// PanicTestFunc defines a function that should, or should not, panic,
+// to be checked with assert.Panics(...) and its variants.
+type PanicTestFunc = common.PanicTestFunc
+
+// Matcher defines a check of values, used with assert.That(...). Write
+// domain matchers implementing it, to have their descriptions on
+// failures.
+type Matcher = common.Matcher
+
+// EqualOption defines an option on how assert.EqualWith(...) compares
+// values. Options only apply to values of the same type: others are
+// compared as assert.Equal(...) does, keeping just the Message option.
+type EqualOption = common.EqualOption
+
 // Golden defines an object to access test input and output through
 // various test cases.
 type Golden = common.Golden
@@ -26,6 +48,148 @@ func S(args ...interface{}) (s Arguments) {
 	return
 }
 
+// Skip marks the test function of an It sentence to not be executed,
+// having the verification reported as skipped instead.
+//
+//    it("should do something", bdd.Skip(func(assert bdd.Assert) {
+//        // ...
+//    }))
+func Skip(fn interface{}) (s interface{}) {
+	s = skippedFunc{fn}
+	return
+}
+
+// Helper marks the function calling it as a helper, like t.Helper(),
+// so failures of assertions made inside it are located where it was
+// called instead. Functions calling only t.Helper() are not skipped, as
+// testing doesn't tell which they are.
+//
+//    func assertPainted(assert bdd.Assert, d *Dog) {
+//        bdd.Helper()
+//        assert.NotEqual("", d.Color)
+//    }
+func Helper() {
+	spec.Helper()
+}
+
+// EqualTo returns a Matcher of values equal to expected, as
+// assert.Equal(...) considers them.
+func EqualTo(expected interface{}) (m Matcher) {
+	m = assert.EqualTo(expected)
+	return
+}
+
+// AllOf returns a Matcher of values matched by every one of matchers.
+//
+//    assert.That(age, bdd.AllOf(bdd.Not(bdd.EqualTo(0)), bdd.Approximately(30, 5)))
+func AllOf(matchers ...Matcher) (m Matcher) {
+	m = assert.AllOf(matchers...)
+	return
+}
+
+// AnyOf returns a Matcher of values matched by one of matchers, at
+// least.
+func AnyOf(matchers ...Matcher) (m Matcher) {
+	m = assert.AnyOf(matchers...)
+	return
+}
+
+// Not returns a Matcher of values not matched by matcher.
+func Not(matcher Matcher) (m Matcher) {
+	m = assert.Not(matcher)
+	return
+}
+
+// HasField returns a Matcher of structs, or pointers to them, having
+// an exported field named name, whose value is matched by matcher.
+//
+//    assert.That(dog, bdd.HasField("Name", bdd.EqualTo("Rex")))
+func HasField(name string, matcher Matcher) (m Matcher) {
+	m = assert.HasField(name, matcher)
+	return
+}
+
+// HasLen returns a Matcher of values, accepted by len(), with length
+// expected.
+func HasLen(length int) (m Matcher) {
+	m = assert.HasLen(length)
+	return
+}
+
+// MatchesRegexp returns a Matcher of strings, byte slices, errors or
+// fmt.Stringers whose text matches the regular expression pattern.
+func MatchesRegexp(pattern string) (m Matcher) {
+	m = assert.MatchesRegexp(pattern)
+	return
+}
+
+// Approximately returns a Matcher of numbers, of any kind, within delta
+// of expected.
+func Approximately(expected, delta float64) (m Matcher) {
+	m = assert.Approximately(expected, delta)
+	return
+}
+
+// IgnoreFields returns an option of assert.EqualWith(...) leaving out
+// of comparison the struct fields named, at any depth. Names may be
+// paths of fields, like "Owner.CreatedAt", to ignore only those under
+// Owner.
+//
+//    assert.EqualWith(expected, user, bdd.IgnoreFields("ID", "CreatedAt"))
+func IgnoreFields(names ...string) (opt EqualOption) {
+	opt = assert.IgnoreFields(names...)
+	return
+}
+
+// IgnoreUnexported returns an option of assert.EqualWith(...) leaving
+// out of comparison every unexported struct field.
+func IgnoreUnexported() (opt EqualOption) {
+	opt = assert.IgnoreUnexported()
+	return
+}
+
+// SortSlices returns an option of assert.EqualWith(...) sorting slices
+// and arrays of T with less, a func(a, b T) bool, before comparing
+// them, so their order doesn't matter.
+//
+//    assert.EqualWith(expected, tags, bdd.SortSlices(func(a, b string) bool { return a < b }))
+func SortSlices(less interface{}) (opt EqualOption) {
+	opt = assert.SortSlices(less)
+	return
+}
+
+// FloatTolerance returns an option of assert.EqualWith(...) taking
+// floats as equal when they differ by up to tolerance.
+func FloatTolerance(tolerance float64) (opt EqualOption) {
+	opt = assert.FloatTolerance(tolerance)
+	return
+}
+
+// EquateEmpty returns an option of assert.EqualWith(...) taking nil
+// slices and maps as equal to empty ones.
+func EquateEmpty() (opt EqualOption) {
+	opt = assert.EquateEmpty()
+	return
+}
+
+// Comparer returns an option of assert.EqualWith(...) comparing values
+// of T with equal, a func(a, b T) bool, instead of field by field.
+//
+//    assert.EqualWith(expected, event, bdd.Comparer(func(a, b time.Time) bool { return a.Equal(b) }))
+func Comparer(equal interface{}) (opt EqualOption) {
+	opt = assert.Comparer(equal)
+	return
+}
+
+// Message returns an option of assert.EqualWith(...) adding a message
+// to its failures, formatted like fmt.Sprintf(format, args...).
+//
+//    assert.EqualWith(expected, user, bdd.IgnoreFields("ID"), bdd.Message("user %s", name))
+func Message(format string, args ...interface{}) (opt EqualOption) {
+	opt = assert.Message(format, args...)
+	return
+}
+
 // Like defines a set of environments to be run on a sentence like
 // Given, When and It. It receives a list of sets of arguments, and
 // those arguments will be used to conduct table-driven tests using
@@ -34,3 +198,15 @@ func Like(sets ...Arguments) (sa []Arguments) {
 	sa = sets
 	return
 }
+
+// Main runs the tests of package, printing the summary of all its
+// specifications at the end, and closing every reporter. Use it on
+// TestMain.
+//
+//    func TestMain(m *testing.M) {
+//        os.Exit(bdd.Main(m))
+//    }
+func Main(m *testing.M) (code int) {
+	code = spec.Main(m)
+	return
+}
@@ -45,8 +45,14 @@ func (m *specificationTesting) Errorf(format string, args ...interface{}) {
 	// using string foo
 	err := fmt.Sprintf(format, args...)
 	err = strings.Replace(err, "\r", "", -1)
-	err = strings.Replace(err, "        ", "\t\t\t", -1) // some errors are two-liners
-	lines := strings.Split(err, "\n")
+
+	// the diff of values is kept as is, to preserve its alignment.
+	head, diff := err, ""
+	if i := strings.Index(err, assert.DiffHeader); i >= 0 {
+		head, diff = err[:i], err[i:]
+	}
+	head = strings.Replace(head, "        ", "\t\t\t", -1) // some errors are two-liners
+	lines := strings.Split(head+diff, "\n")
 	out := ""
 
 	for i := range lines {
@@ -59,11 +65,9 @@ func (m *specificationTesting) Errorf(format string, args ...interface{}) {
 		}
 	}
 
-	m.spec.PrintItWithError()
 	// to properly set the caller used, we currently need to call
-	// m.spec.PrinterError here to capture the proper line number.
-	// and since PrintError() comes after PrintTitleWithError(),
-	// we have the line above.
+	// m.spec.PrintError here to capture the proper line number. The
+	// failure is only handed to reporters when the verification ends.
 	//
 	// TODO refactor to pass the caller information down along with
 	// the custom error message parsing.  that way we can control the
@@ -71,6 +75,51 @@ func (m *specificationTesting) Errorf(format string, args ...interface{}) {
 	m.spec.PrintError(out)
 }
 
+// Group gathers the failures registered while fn runs, registering
+// them as a single failure, located where the group was made, with a
+// numbered list of their messages and lines.
+func (m *specificationTesting) Group(name string, fn func()) {
+	start := len(m.spec.failures)
+	fn()
+
+	if len(m.spec.failures) == start {
+		return
+	}
+
+	grouped := m.spec.takeFailures(start)
+	m.spec.PrintError(groupMessage(name, grouped))
+}
+
+// groupMessage returns the message of a failure gathering failures of
+// the group named, numbering them with their lines.
+func groupMessage(name string, failures []Failure) (message string) {
+	head := fmt.Sprintf("%d assertions failed", len(failures))
+	if name != "" {
+		head = fmt.Sprintf("%s on %s", head, name)
+	}
+
+	lines := []string{fmt.Sprintf("\tError:\t\t%s:", head)}
+	for i, f := range failures {
+		lines = append(lines, fmt.Sprintf("\t%d. %s:%d", i+1, moduleRelative(f.File), f.Line))
+		lines = append(lines, "\t"+strings.Replace(f.Message, "\n", "\n\t", -1))
+	}
+
+	message = strings.Join(lines, "\n")
+	return
+}
+
+// abortedIt is panicked to stop the verification running, after a
+// failed assertion of Require().
+type abortedIt struct{}
+
+// FailNow stops the verification running, when an assertion of
+// Require() fails. The failure was already registered by Errorf. The
+// panic is only recovered on the goroutine running the verification, so
+// on any other one it crashes the test binary.
+func (m *specificationTesting) FailNow() {
+	panic(abortedIt{})
+}
+
 // newAsserter constructs a wrapper around Testify's asserts.
 func newAsserter(s *TestSpecification) (a common.Assert) {
 	a = assert.New(&specificationTesting{
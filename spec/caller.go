@@ -0,0 +1,87 @@
+package spec
+
+import (
+	"path"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+var (
+	// modulePath is the import path of bdd module, whose packages are
+	// skipped when looking for the failing line.
+	modulePath = path.Dir(reflect.TypeOf(Configuration{}).PkgPath())
+
+	// helpers holds the names of functions marked as helpers.
+	helpers sync.Map
+)
+
+// Frame is a function call on the chain leading to a failure.
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// Helper marks the function calling it as a helper, like t.Helper(),
+// so failures of assertions made inside it are located where it was
+// called instead. Functions calling only t.Helper() are not skipped, as
+// testing doesn't tell which they are.
+//
+//    func assertPainted(assert bdd.Assert, d *Dog) {
+//        spec.Helper()
+//        assert.NotEqual("", d.Color)
+//    }
+func Helper() {
+	if frames := userFrames(); len(frames) > 0 {
+		helpers.Store(frames[0].Function, true)
+	}
+}
+
+// funcPackage returns the import path of package of function named
+// name, like github.com/ddsgok/bdd/spec for its Helper function.
+func funcPackage(name string) (pkg string) {
+	slash := strings.LastIndex(name, "/")
+	if dot := strings.Index(name[slash+1:], "."); dot >= 0 {
+		pkg = name[:slash+1+dot]
+	} else {
+		pkg = name
+	}
+	return
+}
+
+// isFramework tells if function named name, declared on file, belongs
+// to bdd packages, out of their tests and examples, or to packages
+// calling tests.
+func isFramework(name, file string) (b bool) {
+	switch pkg := funcPackage(name); {
+	case strings.HasSuffix(file, "_test.go"):
+	case pkg == modulePath, pkg == modulePath+"/spec", pkg == modulePath+"/colors":
+		b = true
+	case strings.HasPrefix(pkg, modulePath+"/internal/"):
+		b = true
+	case pkg == "runtime", pkg == "testing", pkg == "reflect":
+		b = true
+	}
+	return
+}
+
+// userFrames returns the chain of calls made by user code, from the
+// innermost one, skipping bdd packages and functions marked as
+// helpers.
+func userFrames() (frames []Frame) {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+
+	fs := runtime.CallersFrames(pcs[:n])
+	for more := n > 0; more; {
+		var f runtime.Frame
+		f, more = fs.Next()
+
+		if _, helper := helpers.Load(f.Function); !helper && !isFramework(f.Function, f.File) {
+			frames = append(frames, Frame{Function: f.Function, File: f.File, Line: f.Line})
+		}
+	}
+	return
+}
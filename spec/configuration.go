@@ -1,9 +1,9 @@
 package spec
 
 import (
-	"strings"
+	"io"
+	"os"
 
-	"github.com/ddsgok/bdd/colors"
 	"github.com/ddsgok/bdd/internal/common"
 )
 
@@ -12,8 +12,14 @@ const (
 	OutputNone outputType = 1 << iota
 	// OutputStdout sets the system to print as usual on Stdout.
 	OutputStdout
-	// OutputStderr
-	// OutputHTML
+	// OutputStderr sets the system to print on Stderr.
+	OutputStderr
+	// OutputWriter sets the system to print on Writer of configuration.
+	OutputWriter
+	// OutputTestLog sets the system to print each context through t.Log
+	// of the test running it, so go test groups output by test and only
+	// shows it on failures or with -v.
+	OutputTestLog
 )
 
 var (
@@ -26,7 +32,13 @@ type outputType int
 
 // Configuration defines the configuration used by the package.
 type Configuration struct {
-	Output outputType
+	Output    outputType
+	Writer    io.Writer
+	Verbosity Verbosity
+
+	Strict         bool
+	AllowedPending []string
+	ContextLines   int
 
 	AnsiOfFeature            string
 	AnsiOfGiven              string
@@ -37,7 +49,17 @@ type Configuration struct {
 	AnsiOfCode               string
 	AnsiOfCodeError          string
 	AnsiOfExpectedError      string
+	AnsiOfKeyword            string
+	AnsiOfString             string
+	AnsiOfNumber             string
+	AnsiOfComment            string
+	Hyperlinks               bool
+
+	MarkOfThen               string
+	MarkOfThenWithError      string
+	MarkOfThenNotImplemented string
 
+	theme    *Theme
 	assertFn func(*TestSpecification) common.Assert
 
 	LastFeature string
@@ -73,6 +95,9 @@ func init() {
 	// set to verbose output by default
 	SetVerbose()
 
+	// register the default console printer
+	SetReporters(NewConsoleReporter())
+
 	// register the default Assertions package
 	SetAssertionsFn(func(s *TestSpecification) (a common.Assert) {
 		a = newAsserter(s)
@@ -80,6 +105,36 @@ func init() {
 	})
 }
 
+// writer returns where output must be printed, according to Output.
+// When output goes through t.Log, or Writer is missing, Stdout is
+// used.
+func (c *Configuration) writer() (w io.Writer) {
+	switch {
+	case c.Output == OutputStderr:
+		w = os.Stderr
+	case c.Output == OutputWriter && c.Writer != nil:
+		w = c.Writer
+	default:
+		w = os.Stdout
+	}
+	return
+}
+
+// contextLines returns how many lines are shown around a failing one,
+// where zero means the default of one line, and a negative number no
+// excerpt at all, only the location.
+func (c *Configuration) contextLines() (n int) {
+	switch {
+	case c.ContextLines == 0:
+		n = 1
+	case c.ContextLines > 0:
+		n = c.ContextLines
+	default:
+		n = -1
+	}
+	return
+}
+
 // Config returns current configuration for system.
 func Config() *Configuration {
 	return config
@@ -107,19 +162,11 @@ func SetConfig(c Configuration) {
 	config = &c
 }
 
-// ResetConfig will reset all options back to their default configuration.
-// Useful for custom colors in the middle of a specification.
+// ResetConfig will reset all options back to their default configuration,
+// using the default theme. Useful for custom colors in the middle of a
+// specification.
 func ResetConfig() {
 	// setup a default configuration
-	config = &Configuration{
-		AnsiOfFeature:            strings.Join([]string{colors.White}, ""),
-		AnsiOfGiven:              strings.Join([]string{colors.Grey}, ""),
-		AnsiOfWhen:               strings.Join([]string{colors.LightGreen}, ""),
-		AnsiOfThen:               strings.Join([]string{colors.Green}, ""),
-		AnsiOfThenNotImplemented: strings.Join([]string{colors.LightYellow}, ""),
-		AnsiOfThenWithError:      strings.Join([]string{colors.RegBg, colors.White, colors.Bold}, ""),
-		AnsiOfCode:               strings.Join([]string{colors.Grey}, ""),
-		AnsiOfCodeError:          strings.Join([]string{colors.White, colors.Bold}, ""),
-		AnsiOfExpectedError:      strings.Join([]string{colors.Red}, ""),
-	}
+	config = &Configuration{}
+	config.applyTheme(themes["default"])
 }
@@ -0,0 +1,365 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ddsgok/bdd/colors"
+	"github.com/ddsgok/bdd/internal/assert"
+)
+
+// consoleReporter prints the specification tree with colors, using
+// the ansi codes of current configuration. When output goes through
+// t.Log, each context is kept on a buffer of the test running it, t,
+// until it finishes. Headers of feature, context and situation are
+// kept until needed, when the verbosity prints only failures.
+type consoleReporter struct {
+	mutex   sync.Mutex
+	buffers map[*testing.T]*bytes.Buffer
+	t       *testing.T
+	feature Event
+	given   Event
+	when    Event
+	shown   int
+	failed  []Event
+	dots    bool
+}
+
+// NewConsoleReporter creates the colored console reporter, used by
+// default on the package.
+func NewConsoleReporter() (r Reporter) {
+	r = &consoleReporter{buffers: make(map[*testing.T]*bytes.Buffer)}
+	return
+}
+
+// silent tells if output is disabled.
+func (cr *consoleReporter) silent() (b bool) {
+	b = config.Output == OutputNone
+	return
+}
+
+// tree tells if the whole specification tree is printed.
+func (cr *consoleReporter) tree() (b bool) {
+	b = !cr.silent() && config.Verbosity >= VerbosityNormal
+	return
+}
+
+// progress tells if a character is printed for each verification.
+func (cr *consoleReporter) progress() (b bool) {
+	b = !cr.silent() && config.Verbosity == VerbosityProgress
+	return
+}
+
+// quiet tells if only failed verifications are printed.
+func (cr *consoleReporter) quiet() (b bool) {
+	b = !cr.silent() && config.Verbosity <= VerbosityQuiet
+	return
+}
+
+// paint wraps text with ansi code, and a reset after it. Text is left
+// as is when there's no code to use.
+func paint(ansi, text string) (s string) {
+	if s = text; ansi != "" {
+		s = ansi + text + colors.Reset
+	}
+	return
+}
+
+// mark returns the mark printed before a verification, using the
+// default one when configuration has none.
+func mark(m string) (s string) {
+	if s = m; s == "" {
+		s = "»"
+	}
+	return
+}
+
+// itLabel returns the verification of event as printed, where an
+// undefined context or situation has no verification.
+func itLabel(e Event) (s string) {
+	if s = "UNDEFINED"; e.It != "" {
+		s = "It " + e.It
+	}
+	return
+}
+
+// location returns file:line:column, with file relative to its module
+// root, as a hyperlink to file when enabled. Column is left out when
+// zero.
+func location(file string, line, column int) (s string) {
+	s = fmt.Sprintf("%s:%d", moduleRelative(file), line)
+	if column > 0 {
+		s += fmt.Sprintf(":%d", column)
+	}
+
+	s = paint(config.AnsiOfCode, s)
+	if config.Hyperlinks {
+		s = colors.Hyperlink("file://"+filepath.ToSlash(file), s)
+	}
+	return
+}
+
+// out returns where to print, according to current configuration.
+func (cr *consoleReporter) out() (w io.Writer) {
+	if config.Output == OutputTestLog {
+		w = cr.buffer(cr.t)
+	} else {
+		w = config.writer()
+	}
+	return
+}
+
+// buffer returns the buffer of output of test t, creating if needed.
+func (cr *consoleReporter) buffer(t *testing.T) (b *bytes.Buffer) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	var ok bool
+	if b, ok = cr.buffers[t]; !ok {
+		b = &bytes.Buffer{}
+		cr.buffers[t] = b
+	}
+	return
+}
+
+// flush logs the buffered output of test t through t.Log, or prints it
+// on Stdout when there's no test, forgetting its buffer.
+func (cr *consoleReporter) flush(t *testing.T) {
+	if t != nil {
+		t.Helper()
+	}
+
+	cr.mutex.Lock()
+	b, ok := cr.buffers[t]
+	delete(cr.buffers, t)
+	cr.mutex.Unlock()
+
+	if !ok || b.Len() == 0 {
+		return
+	}
+
+	if t != nil {
+		t.Log("\n" + strings.TrimRight(b.String(), "\n"))
+	} else {
+		_, _ = b.WriteTo(config.writer())
+	}
+}
+
+// showHeaders prints the headers of feature, context and situation
+// up to level, that were not printed yet.
+func (cr *consoleReporter) showHeaders(level int) {
+	for ; cr.shown < level; cr.shown++ {
+		switch cr.shown {
+		case 0:
+			fmt.Fprintln(cr.out(), paint(config.AnsiOfFeature, "Feature: "+cr.feature.Feature))
+		case 1:
+			fmt.Fprintln(cr.out(), paint(config.AnsiOfGiven, "  Given "+withLeftPadding(cr.given.Given, 2)))
+		case 2:
+			if cr.when.When != "" {
+				fmt.Fprintln(cr.out(), paint(config.AnsiOfWhen, "    When "+cr.when.When))
+			}
+		}
+	}
+}
+
+// itText returns the verification of event as printed, along with its
+// arguments and duration on debug verbosity.
+func (cr *consoleReporter) itText(e Event) (s string) {
+	s = itLabel(e)
+	if config.Verbosity >= VerbosityDebug {
+		if len(e.Args) > 0 {
+			s += fmt.Sprintf(" %v", e.Args)
+		}
+		s += fmt.Sprintf(" (%s)", e.Duration.Round(time.Microsecond))
+	}
+	return
+}
+
+// dot prints the character of a verification, on progress verbosity.
+func (cr *consoleReporter) dot(ansi, c string) {
+	fmt.Fprint(cr.out(), paint(ansi, c))
+	cr.dots = true
+}
+
+// FeatureStarted prints line informing about feature being tested.
+func (cr *consoleReporter) FeatureStarted(e Event) {
+	cr.t, cr.feature, cr.shown = e.T, e, 0
+	if cr.tree() {
+		cr.showHeaders(1)
+	}
+}
+
+// GivenStarted prints line informing about context being tested.
+func (cr *consoleReporter) GivenStarted(e Event) {
+	cr.t, cr.given, cr.when = e.T, e, Event{}
+	if cr.shown > 1 {
+		cr.shown = 1
+	}
+
+	if cr.tree() {
+		cr.showHeaders(2)
+	}
+}
+
+// WhenStarted prints line informing about situation being tested.
+func (cr *consoleReporter) WhenStarted(e Event) {
+	cr.t, cr.when = e.T, e
+	if cr.shown > 2 {
+		cr.shown = 2
+	}
+
+	if cr.tree() {
+		cr.showHeaders(3)
+	}
+}
+
+// ItPassed prints line informing about verification being tested when
+// successful.
+func (cr *consoleReporter) ItPassed(e Event) {
+	cr.t = e.T
+	if cr.tree() {
+		fmt.Fprintln(cr.out(), paint(config.AnsiOfThen, fmt.Sprintf("    %s %s ", mark(config.MarkOfThen), cr.itText(e))))
+	} else if cr.progress() {
+		cr.dot(config.AnsiOfThen, ".")
+	}
+}
+
+// ItFailed prints, for each failure, the line informing about
+// verification and the text detailing how it failed. Failures are
+// kept to the end of context on progress verbosity.
+func (cr *consoleReporter) ItFailed(e Event) {
+	cr.t = e.T
+	if cr.progress() {
+		cr.dot(config.AnsiOfExpectedError, "F")
+		cr.failed = append(cr.failed, e)
+		return
+	}
+
+	if cr.quiet() {
+		cr.showHeaders(3)
+	}
+
+	if cr.tree() || cr.quiet() {
+		cr.printFailed(e, cr.itText(e))
+	}
+}
+
+// ItPending prints line informing about verification not implemented.
+func (cr *consoleReporter) ItPending(e Event) {
+	cr.t = e.T
+	if cr.tree() {
+		fmt.Fprintln(cr.out(), paint(config.AnsiOfThenNotImplemented, fmt.Sprintf("    %s %s «-- NOT IMPLEMENTED", mark(config.MarkOfThenNotImplemented), cr.itText(e))))
+	} else if cr.progress() {
+		cr.dot(config.AnsiOfThenNotImplemented, "*")
+	}
+}
+
+// ItSkipped prints line informing about verification skipped.
+func (cr *consoleReporter) ItSkipped(e Event) {
+	cr.t = e.T
+	if cr.tree() {
+		fmt.Fprintln(cr.out(), paint(config.AnsiOfThenNotImplemented, fmt.Sprintf("    %s %s «-- SKIPPED", mark(config.MarkOfThenNotImplemented), cr.itText(e))))
+	} else if cr.progress() {
+		cr.dot(config.AnsiOfThenNotImplemented, "S")
+	}
+}
+
+// GivenFinished prints a blank line separating contexts, and on
+// progress verbosity, the failed verifications of context. When output
+// goes through t.Log, the whole context is logged on the test running
+// it, or printed on Stdout when there's no test.
+func (cr *consoleReporter) GivenFinished(e Event) {
+	if e.T != nil {
+		e.T.Helper()
+	}
+	cr.t = e.T
+
+	switch {
+	case cr.tree():
+		if config.Verbosity >= VerbosityDebug {
+			fmt.Fprintln(cr.out(), paint(config.AnsiOfCode, fmt.Sprintf("    (%s)", e.Duration.Round(time.Microsecond))))
+		}
+		fmt.Fprintln(cr.out())
+	case cr.progress():
+		if cr.dots {
+			fmt.Fprintln(cr.out())
+		}
+		for _, f := range cr.failed {
+			cr.printFailed(f, markdownLine(f.Path()))
+		}
+	case cr.quiet():
+		if cr.shown > 1 {
+			fmt.Fprintln(cr.out())
+		}
+	}
+	cr.failed, cr.dots = nil, false
+
+	cr.flush(e.T)
+}
+
+// printFailed prints, for each failure of event, the line informing
+// about verification, as text, and the failure.
+func (cr *consoleReporter) printFailed(e Event, text string) {
+	for _, f := range e.Failures {
+		fmt.Fprintln(cr.out(), paint(config.AnsiOfThenWithError, fmt.Sprintf("    %s %s ", mark(config.MarkOfThenWithError), text)))
+		cr.printFailure(f)
+	}
+}
+
+// printFailure prints the failure message and the location where
+// assertion failed, with the excerpt of code around it, if any. Lines of a diff are highlighted by change,
+// with expected values as successful and actual ones as errors.
+func (cr *consoleReporter) printFailure(f Failure) {
+	inDiff := false
+	for _, l := range strings.Split(f.Message, "\n") {
+		ansi, text := config.AnsiOfExpectedError, strings.TrimLeft(l, "\t")
+		inDiff = inDiff && !strings.HasPrefix(text, "Messages:")
+		switch {
+		case !inDiff:
+		case strings.HasPrefix(text, "-"):
+			ansi = config.AnsiOfThen
+		case strings.HasPrefix(text, "+"):
+			ansi = config.AnsiOfExpectedError
+		default:
+			ansi = config.AnsiOfCode
+		}
+		inDiff = inDiff || strings.Contains(l, assert.DiffHeader)
+
+		fmt.Fprintln(cr.out(), paint(ansi, l))
+	}
+
+	if f.File == "" {
+		return
+	}
+
+	fmt.Fprintln(cr.out(), paint(config.AnsiOfCode, "        in ")+location(f.File, f.Line, f.Column))
+	if len(f.Excerpt) > 0 {
+		fmt.Fprintln(cr.out(), paint(config.AnsiOfCode, "        ---------"))
+
+		width := len(fmt.Sprintf("%d", f.Excerpt[len(f.Excerpt)-1].Number))
+		for _, l := range f.Excerpt {
+			number := colors.PadLeft(fmt.Sprintf("%d.", l.Number), width+1)
+			if l.Number != f.Line {
+				fmt.Fprintf(cr.out(), "%s %s\n", paint(config.AnsiOfCode, "        "+number), highlight(l.Text, 0, 0))
+				continue
+			}
+
+			fmt.Fprintf(cr.out(), "%s %s\n", paint(config.AnsiOfCodeError, "        "+number), highlight(l.Text, l.Start, l.End))
+			if config.AnsiOfCode == "" && l.End > l.Start {
+				fmt.Fprintf(cr.out(), "        %s %s^%s\n", strings.Repeat(" ", width+1), strings.Repeat(" ", l.Start), strings.Repeat("~", l.End-l.Start-1))
+			}
+		}
+	}
+	for _, fr := range f.callers() {
+		fmt.Fprintln(cr.out(), paint(config.AnsiOfCode, "        called from ")+location(fr.File, fr.Line, 0))
+	}
+	fmt.Fprintln(cr.out())
+
+	fmt.Fprintln(cr.out())
+}
@@ -0,0 +1,232 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// nonIDChars matches characters replaced on cucumber ids.
+	nonIDChars = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// cucumberFeature is a feature on Cucumber JSON format.
+type cucumberFeature struct {
+	URI         string             `json:"uri"`
+	ID          string             `json:"id"`
+	Keyword     string             `json:"keyword"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Line        int                `json:"line"`
+	Elements    []*cucumberElement `json:"elements"`
+}
+
+// cucumberElement is a scenario on Cucumber JSON format, representing
+// each verification.
+type cucumberElement struct {
+	ID          string          `json:"id"`
+	Keyword     string          `json:"keyword"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Line        int             `json:"line"`
+	Type        string          `json:"type"`
+	Steps       []*cucumberStep `json:"steps"`
+}
+
+// cucumberStep is a step on Cucumber JSON format, representing the
+// Given, When and It sentences.
+type cucumberStep struct {
+	Keyword string         `json:"keyword"`
+	Name    string         `json:"name"`
+	Line    int            `json:"line"`
+	Match   cucumberMatch  `json:"match"`
+	Result  cucumberResult `json:"result"`
+}
+
+// cucumberMatch tells where a step was declared.
+type cucumberMatch struct {
+	Location string `json:"location"`
+}
+
+// cucumberResult is the outcome of a step, with duration in
+// nanoseconds.
+type cucumberResult struct {
+	Status       string `json:"status"`
+	Duration     int64  `json:"duration,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// cucumberReporter stores results by feature, and writes them as
+// Cucumber JSON to a file when closed.
+type cucumberReporter struct {
+	filename  string
+	features  map[string]*cucumberFeature
+	order     []string
+	givenLine int
+	whenLine  int
+}
+
+// NewCucumberReporter creates a reporter writing a Cucumber JSON
+// report on filename, so tools made for Cucumber can render results.
+// Each verification becomes a scenario, with Given, When and Then
+// steps. The file is written at the end of each test running
+// specifications, so no TestMain is needed, and a last time when
+// spec.Close() is called.
+//
+//    spec.AddReporter(spec.NewCucumberReporter("cucumber.json"))
+//
+// It's also enabled with -bdd.cucumber=cucumber.json flag or
+// BDD_CUCUMBER environment variable.
+func NewCucumberReporter(filename string) (r Reporter) {
+	r = newFileReporter(&cucumberReporter{
+		filename: filename,
+		features: make(map[string]*cucumberFeature),
+	})
+	return
+}
+
+// cucumberID transforms text into an id, as Cucumber does.
+func cucumberID(text string) (id string) {
+	id = strings.Trim(nonIDChars.ReplaceAllString(strings.ToLower(text), "-"), "-")
+	return
+}
+
+// relativePath returns file relative to working dir, when possible.
+func relativePath(file string) (r string) {
+	r = file
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, file); err == nil {
+			r = rel
+		}
+	}
+	return
+}
+
+// feature returns the feature of event, creating if needed.
+func (cr *cucumberReporter) feature(e Event) (f *cucumberFeature) {
+	var ok bool
+	if f, ok = cr.features[e.Feature]; !ok {
+		f = &cucumberFeature{
+			URI:     relativePath(e.File),
+			ID:      cucumberID(e.Feature),
+			Keyword: "Feature",
+			Name:    e.Feature,
+			Line:    e.Line,
+		}
+		cr.features[e.Feature] = f
+		cr.order = append(cr.order, e.Feature)
+	}
+	return
+}
+
+// step creates a step declared on line.
+func (cr *cucumberReporter) step(e Event, keyword, name string, line int, status Status) (s *cucumberStep) {
+	s = &cucumberStep{
+		Keyword: keyword,
+		Name:    name,
+		Line:    line,
+		Match:   cucumberMatch{Location: fmt.Sprintf("%s:%d", relativePath(e.File), line)},
+		Result:  cucumberResult{Status: status.String()},
+	}
+	return
+}
+
+// addScenario includes a scenario for the verification on event,
+// returning its Then step.
+func (cr *cucumberReporter) addScenario(e Event) (then *cucumberStep) {
+	f := cr.feature(e)
+	then = cr.step(e, "Then ", e.It, e.Line, e.Status)
+	then.Result.Duration = e.Duration.Nanoseconds()
+
+	f.Elements = append(f.Elements, &cucumberElement{
+		ID:      f.ID + ";" + cucumberID(e.Path()),
+		Keyword: "Scenario",
+		Name:    e.It,
+		Line:    e.Line,
+		Type:    "scenario",
+		Steps: []*cucumberStep{
+			cr.step(e, "Given ", e.Given, cr.givenLine, StatusPassed),
+			cr.step(e, "When ", e.When, cr.whenLine, StatusPassed),
+			then,
+		},
+	})
+	return
+}
+
+// FeatureStarted creates the feature.
+func (cr *cucumberReporter) FeatureStarted(e Event) {
+	cr.feature(e)
+}
+
+// GivenStarted stores the line of context, used on Given steps.
+func (cr *cucumberReporter) GivenStarted(e Event) {
+	cr.givenLine = e.Line
+}
+
+// WhenStarted stores the line of situation, used on When steps.
+func (cr *cucumberReporter) WhenStarted(e Event) {
+	cr.whenLine = e.Line
+}
+
+// ItPassed includes a passed scenario.
+func (cr *cucumberReporter) ItPassed(e Event) {
+	cr.addScenario(e)
+}
+
+// ItFailed includes a failed scenario, with all failure messages on
+// its Then step.
+func (cr *cucumberReporter) ItFailed(e Event) {
+	then := cr.addScenario(e)
+
+	var messages []string
+	for _, f := range e.Failures {
+		messages = append(messages, f.Details())
+	}
+	then.Result.ErrorMessage = strings.Join(messages, "\n\n")
+}
+
+// ItPending includes a pending scenario.
+func (cr *cucumberReporter) ItPending(e Event) {
+	cr.addScenario(e)
+}
+
+// ItSkipped includes a skipped scenario.
+func (cr *cucumberReporter) ItSkipped(e Event) {
+	cr.addScenario(e)
+}
+
+// GivenFinished does nothing, since the report is written when closed.
+func (cr *cucumberReporter) GivenFinished(e Event) {}
+
+// Close writes the report.
+func (cr *cucumberReporter) Close() (err error) {
+	err = cr.write()
+	return
+}
+
+// write saves the whole report on file.
+func (cr *cucumberReporter) write() (err error) {
+	features := []*cucumberFeature{}
+	for _, name := range cr.order {
+		features = append(features, cr.features[name])
+	}
+
+	var bytes []byte
+	if bytes, err = json.MarshalIndent(features, "", "  "); err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(filepath.Dir(cr.filename), 0755); err == nil {
+		err = ioutil.WriteFile(cr.filename, bytes, 0644)
+	}
+
+	err = errors.Wrap(err, "failed to write Cucumber report")
+	return
+}
@@ -8,6 +8,75 @@ print correct information.
 
 The colors used on the output, can be changed using spec.SetConfig(),
 where you can create different Configuration object, using different
-colors for each type of line printed.
+colors for each type of line printed. Named themes are also available
+through spec.SetTheme(), as default, high-contrast, monochrome (with
+✓ and ✗ marks) and light-background, or with -bdd.theme flag and
+BDD_THEME environment variable. An unknown theme on flag is told on
+stderr, and the default one is used.
+
+Colors are only printed on terminals, checked again whenever output
+changes. NO_COLOR environment variable disables them anywhere, while
+FORCE_COLOR enables them even on files and CI logs.
+
+Everything printed is sent through a Reporter, receiving events about
+each feature, context, situation and verification. The colored console
+printer is registered by default, and others can be registered along
+with it through spec.AddReporter(), or replace it with
+spec.SetReporters().
+
+Output is printed on Stdout by default. It can be sent to any
+io.Writer with spec.SetWriter(), or through t.Log of the test running
+each context with spec.SetOutput(spec.OutputTestLog), so go test groups
+it by test and only shows it on failures or with -v. The same is set
+with -bdd.output flag, or BDD_OUTPUT environment variable, as stdout,
+stderr, testlog or none.
+
+Failures are located on the innermost call made by user code, showing
+an excerpt of code around it, and the chain of calls leading to it.
+Locations are printed as file:line:column, relative to module root,
+and linked to the file on terminals supporting hyperlinks, unless
+colors are forced, or a Theme turns Hyperlinks on. Excerpts are
+highlighted as Go code, with the failing call underlined.
+Functions calling spec.Helper(), or bdd.Helper(), are skipped, as
+t.Helper() does for testing, but calling only t.Helper() isn't enough.
+The lines shown around failing ones are set on Configuration
+ContextLines, -bdd.context flag or BDD_CONTEXT environment variable,
+where a negative number shows only the location.
+
+How much is printed is set with spec.SetVerbosity(), -bdd.verbosity
+flag or BDD_VERBOSITY environment variable: quiet prints only the path
+of failed verifications, progress a character for each verification
+(. passed, F failed, * pending, S skipped), normal the whole tree, and
+debug the tree with durations and arguments of each verification.
+
+A summary is printed at the end of each test function, with counts of
+verifications by status for each Feature, total duration, the slowest
+verifications and the failed ones. Using spec.Main() on TestMain also
+prints the summary of whole package. On quiet verbosity, summaries are
+only printed when a verification fails. They are disabled with
+-bdd.summary=false flag or BDD_SUMMARY=false environment variable.
+
+Verifications not implemented, and contexts or situations without a
+body, are only reported as pending. On strict mode, set with
+spec.SetStrict(), -bdd.strict flag or BDD_STRICT=true environment
+variable, they fail the test instead. Known pending specifications are
+allowed through a file listing their paths, loaded with
+spec.LoadAllowedPending(), -bdd.allow-pending flag or BDD_ALLOW_PENDING
+environment variable, so their count can be reduced over time. Paths no
+longer matching any specification are told by spec.Close(), or only
+warned when a test was skipped, or -test.short or -test.failfast is set.
+
+Reports for other tools can be enabled on the command line, with paths
+relative to the package being tested:
+
+	go test ./... -args -bdd.junit=report.xml -bdd.html=specs.html
+
+Available flags are -bdd.junit, -bdd.cucumber, -bdd.html,
+-bdd.markdown and -bdd.tap. Each one has an environment variable counterpart, like
+BDD_JUNIT or BDD_MARKDOWN. Reports are written at the end of each test
+running specifications, so they work without TestMain, and a last time
+by spec.Close(), as spec.Main() or bdd.Main() do. A report that can't
+be written fails the test that ended, or the run on spec.Close(), once,
+without stopping the other reports.
 */
 package spec
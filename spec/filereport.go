@@ -0,0 +1,123 @@
+package spec
+
+import (
+	"io"
+	"sync"
+	"testing"
+)
+
+// fileReporter writes the report of another reporter, through its
+// Close, at the end of each test running specifications, so the file
+// is up to date even without spec.Close() on TestMain. A write failing
+// is told only once, failing the test that ended, or returned by
+// Close, and the report isn't written again.
+type fileReporter struct {
+	mutex  sync.Mutex
+	report interface {
+		Reporter
+		io.Closer
+	}
+	tests  map[*testing.T]bool
+	failed bool
+}
+
+// newFileReporter wraps report, written when closed, to be written at
+// the end of each test too.
+func newFileReporter(report interface {
+	Reporter
+	io.Closer
+}) (r Reporter) {
+	r = &fileReporter{report: report, tests: make(map[*testing.T]bool)}
+	return
+}
+
+// track registers the writing of report at the end of test t, once.
+// Unnamed tests, like a zero testing.T, never end, so they're left to
+// Close.
+func (fr *fileReporter) track(t *testing.T) {
+	if t == nil || t.Name() == "" || fr.tests[t] {
+		return
+	}
+
+	fr.tests[t] = true
+	t.Cleanup(func() {
+		fr.mutex.Lock()
+		defer fr.mutex.Unlock()
+
+		delete(fr.tests, t)
+		if err := fr.write(); err != nil {
+			t.Errorf("bdd: %v", err)
+		}
+	})
+}
+
+// write saves the report, unless it failed before.
+func (fr *fileReporter) write() (err error) {
+	if fr.failed {
+		return
+	}
+
+	if err = fr.report.Close(); err != nil {
+		fr.failed = true
+	}
+	return
+}
+
+// forward sends event to report through fn, tracking the test of e.
+func (fr *fileReporter) forward(e Event, fn func(Event)) {
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+
+	fr.track(e.T)
+	fn(e)
+}
+
+// FeatureStarted forwards event to report.
+func (fr *fileReporter) FeatureStarted(e Event) {
+	fr.forward(e, fr.report.FeatureStarted)
+}
+
+// GivenStarted forwards event to report.
+func (fr *fileReporter) GivenStarted(e Event) {
+	fr.forward(e, fr.report.GivenStarted)
+}
+
+// WhenStarted forwards event to report.
+func (fr *fileReporter) WhenStarted(e Event) {
+	fr.forward(e, fr.report.WhenStarted)
+}
+
+// ItPassed forwards event to report.
+func (fr *fileReporter) ItPassed(e Event) {
+	fr.forward(e, fr.report.ItPassed)
+}
+
+// ItFailed forwards event to report.
+func (fr *fileReporter) ItFailed(e Event) {
+	fr.forward(e, fr.report.ItFailed)
+}
+
+// ItPending forwards event to report.
+func (fr *fileReporter) ItPending(e Event) {
+	fr.forward(e, fr.report.ItPending)
+}
+
+// ItSkipped forwards event to report.
+func (fr *fileReporter) ItSkipped(e Event) {
+	fr.forward(e, fr.report.ItSkipped)
+}
+
+// GivenFinished forwards event to report.
+func (fr *fileReporter) GivenFinished(e Event) {
+	fr.forward(e, fr.report.GivenFinished)
+}
+
+// Close writes the report a last time, returning its error unless it
+// was already told.
+func (fr *fileReporter) Close() (err error) {
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+
+	err = fr.write()
+	return
+}
@@ -0,0 +1,153 @@
+package spec
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+var (
+	// junitFile tells where to write a JUnit XML report.
+	junitFile = flag.String("bdd.junit", "", "write a JUnit XML report of specifications to file")
+	// cucumberFile tells where to write a Cucumber JSON report.
+	cucumberFile = flag.String("bdd.cucumber", "", "write a Cucumber JSON report of specifications to file")
+	// htmlFile tells where to write a HTML report.
+	htmlFile = flag.String("bdd.html", "", "write a HTML report of specifications to file")
+	// markdownFile tells where to write a Markdown report.
+	markdownFile = flag.String("bdd.markdown", "", "write a Markdown report of specifications to file")
+	// tapFile tells where to write a TAP report.
+	tapFile = flag.String("bdd.tap", "", "write a TAP report of specifications to file")
+	// output tells where to print specifications.
+	output = flag.String("bdd.output", "", "print specifications on stdout, stderr, testlog or none")
+	// verbosity tells how much of specifications to print.
+	verbosity = flag.String("bdd.verbosity", "", "print specifications as quiet, progress, normal or debug")
+	// theme tells which theme to use when printing specifications.
+	theme = flag.String("bdd.theme", "", "print specifications using theme: default, high-contrast, monochrome or light-background")
+	// summary tells if summaries are printed at the end of tests.
+	summary = flag.Bool("bdd.summary", true, "print a summary of specifications at the end of each test and package")
+	// strict tells if pending specifications must fail.
+	strict = flag.Bool("bdd.strict", false, "fail on pending and undefined specifications")
+	// allowPending tells the file listing pending specifications
+	// allowed on strict mode.
+	allowPending = flag.String("bdd.allow-pending", "", "read pending specifications allowed on strict mode from file")
+	// contextLines tells how many lines to show around failing ones.
+	contextLines = flag.String("bdd.context", "", "show this many lines of code around failing ones, or only their location if negative")
+	// outputs maps names accepted by -bdd.output to output types.
+	outputs = map[string]outputType{
+		"stdout":  OutputStdout,
+		"stderr":  OutputStderr,
+		"testlog": OutputTestLog,
+		"none":    OutputNone,
+	}
+	// flagsOnce ensures flags are applied a single time.
+	flagsOnce sync.Once
+	// flagsErr holds the error found applying flags, reported by the
+	// first specification created.
+	flagsErr error
+	// flagsErrMutex ensures the error of flags is reported a single time.
+	flagsErrMutex sync.Mutex
+)
+
+// flagOrEnv returns value of flag if set, otherwise the value of the
+// environment variable named env.
+func flagOrEnv(value, env string) (r string) {
+	if r = value; r == "" {
+		r = os.Getenv(env)
+	}
+	return
+}
+
+// boolFlagOrEnv returns value of flag named name if set on command
+// line, otherwise the environment variable named env parsed as a bool,
+// or value when it's missing. An invalid variable is told on stderr.
+func boolFlagOrEnv(name string, value bool, env string) (b bool) {
+	b = value
+
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		set = set || f.Name == name
+	})
+	if set {
+		return
+	}
+
+	if s := os.Getenv(env); s != "" {
+		parsed, err := strconv.ParseBool(s)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "bdd: invalid value %q for %s: must be true or false\n", s, env)
+			return
+		}
+		b = parsed
+	}
+	return
+}
+
+// applyFlags sets the output, verbosity, theme and strict mode, and
+// registers the summary, unless disabled, and the reporters asked
+// through flags or environment variables. Flags are only parsed by testing package
+// after init, so this runs on the first specification created.
+func applyFlags() {
+	flagsOnce.Do(func() {
+		if o, ok := outputs[flagOrEnv(*output, "BDD_OUTPUT")]; ok {
+			SetOutput(o)
+		}
+		if v, ok := verbosities[flagOrEnv(*verbosity, "BDD_VERBOSITY")]; ok {
+			SetVerbosity(v)
+		}
+		if name := flagOrEnv(*theme, "BDD_THEME"); name != "" {
+			if err := SetTheme(name); err != nil {
+				fmt.Fprintf(os.Stderr, "bdd: invalid value %q for flag -bdd.theme: %v, using default\n", name, err)
+				_ = SetTheme("default")
+			}
+		}
+		if n, err := strconv.Atoi(flagOrEnv(*contextLines, "BDD_CONTEXT")); err == nil && n != 0 {
+			config.ContextLines = n
+		}
+		if boolFlagOrEnv("bdd.strict", *strict, "BDD_STRICT") {
+			SetStrict(true)
+		}
+		if file := flagOrEnv(*allowPending, "BDD_ALLOW_PENDING"); file != "" {
+			flagsErr = LoadAllowedPending(file)
+		}
+		if boolFlagOrEnv("bdd.summary", *summary, "BDD_SUMMARY") {
+			AddReporter(NewSummaryReporter())
+		}
+		if file := flagOrEnv(*junitFile, "BDD_JUNIT"); file != "" {
+			AddReporter(NewJUnitReporter(file))
+		}
+		if file := flagOrEnv(*cucumberFile, "BDD_CUCUMBER"); file != "" {
+			AddReporter(NewCucumberReporter(file))
+		}
+		if file := flagOrEnv(*htmlFile, "BDD_HTML"); file != "" {
+			AddReporter(NewHTMLReporter(file))
+		}
+		if file := flagOrEnv(*markdownFile, "BDD_MARKDOWN"); file != "" {
+			AddReporter(NewMarkdownReporter(file))
+		}
+		if file := flagOrEnv(*tapFile, "BDD_TAP"); file != "" {
+			AddReporter(NewTAPReporter(file))
+		}
+	})
+}
+
+// reportFlagsError fails test t with the error found applying flags,
+// once. Without a named test, it's told on stderr.
+func reportFlagsError(t *testing.T) {
+	flagsErrMutex.Lock()
+	err := flagsErr
+	flagsErr = nil
+	flagsErrMutex.Unlock()
+
+	if err == nil {
+		return
+	}
+
+	if t != nil && t.Name() != "" {
+		t.Errorf("bdd: %v", err)
+	} else {
+		fmt.Fprintf(os.Stderr, "bdd: %v\n", err)
+	}
+}
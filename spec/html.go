@@ -0,0 +1,186 @@
+package spec
+
+import (
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// htmlReport is the data rendered on HTML template.
+type htmlReport struct {
+	*specTree
+	Generated time.Time
+}
+
+// NewHTMLReporter creates a reporter writing a self-contained HTML
+// report on filename, with a collapsible tree of specifications,
+// filters by status and tag and a search box. Tags are words starting
+// with '@' on any sentence, like "Given @slow a big file". The file
+// is written at the end of each test running specifications, so no
+// TestMain is needed, and a last time when spec.Close() is called.
+//
+//    spec.AddReporter(spec.NewHTMLReporter("specs.html"))
+//
+// It's also enabled with -bdd.html=specs.html flag or BDD_HTML
+// environment variable.
+func NewHTMLReporter(filename string) (r Reporter) {
+	r = newFileReporter(newTreeReporter(func(st *specTree) error {
+		return writeHTML(filename, st)
+	}))
+	return
+}
+
+// writeHTML saves the whole tree as HTML on file.
+func writeHTML(filename string, st *specTree) (err error) {
+	var f *os.File
+	if err = os.MkdirAll(filepath.Dir(filename), 0755); err == nil {
+		if f, err = os.Create(filename); err == nil {
+			defer f.Close()
+			err = htmlTemplate.Execute(f, htmlReport{specTree: st, Generated: time.Now()})
+		}
+	}
+
+	err = errors.Wrap(err, "failed to write HTML report")
+	return
+}
+
+var (
+	// htmlTemplate renders the HTML report.
+	htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+		"join":     strings.Join,
+		"lower":    strings.ToLower,
+		"duration": roundedDuration,
+	}).Parse(htmlLayout))
+)
+
+// roundedDuration formats d rounded to microseconds, as durations are
+// printed on console.
+func roundedDuration(d time.Duration) (s string) {
+	s = d.Round(time.Microsecond).String()
+	return
+}
+
+// htmlLayout is the template of HTML report, with all styles and
+// scripts inlined so it works offline.
+const htmlLayout = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Specifications</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #24292e; }
+header { margin-bottom: 1em; }
+.toolbar { display: flex; gap: 1em; flex-wrap: wrap; align-items: center; margin: 1em 0; }
+.toolbar input[type=search] { padding: .3em; min-width: 20em; }
+details { margin-left: 1.2em; }
+summary { cursor: pointer; padding: .15em 0; }
+.feature > summary { font-size: 1.2em; font-weight: bold; }
+.it { margin-left: 2.4em; padding: .15em 0; }
+.badge { display: inline-block; border-radius: 3px; padding: 0 .4em; font-size: .8em; color: #fff; text-transform: uppercase; }
+.passed > .badge, .badge.passed { background: #28a745; }
+.failed > .badge, .badge.failed { background: #d73a49; }
+.pending > .badge, .badge.pending { background: #dbab09; }
+.skipped > .badge, .badge.skipped { background: #6a737d; }
+.duration, .location { color: #6a737d; font-size: .85em; }
+.tag { color: #0366d6; font-size: .85em; }
+.failure { margin: .3em 0 .6em 2em; }
+.failure .message { color: #d73a49; white-space: pre-wrap; font-family: monospace; }
+pre.excerpt { background: #f6f8fa; padding: .5em; margin: .3em 0; }
+pre.excerpt .error { background: #ffeef0; font-weight: bold; display: block; }
+.hidden { display: none; }
+</style>
+</head>
+<body>
+<header>
+<h1>Specifications</h1>
+<div>
+<span class="badge passed">passed {{index .Counts "passed"}}</span>
+<span class="badge failed">failed {{index .Counts "failed"}}</span>
+<span class="badge pending">pending {{index .Counts "pending"}}</span>
+<span class="badge skipped">skipped {{index .Counts "skipped"}}</span>
+<span class="duration">in {{duration .Duration}}, generated at {{.Generated.Format "2006-01-02 15:04:05"}}</span>
+</div>
+<div class="toolbar">
+<input type="search" id="search" placeholder="Search specifications">
+<label><input type="checkbox" class="status" value="passed" checked> passed</label>
+<label><input type="checkbox" class="status" value="failed" checked> failed</label>
+<label><input type="checkbox" class="status" value="pending" checked> pending</label>
+<label><input type="checkbox" class="status" value="skipped" checked> skipped</label>
+<select id="tag"><option value="">all tags</option>{{range .Tags}}<option>{{.}}</option>{{end}}</select>
+<button type="button" id="expand">expand all</button>
+<button type="button" id="collapse">collapse all</button>
+</div>
+</header>
+{{range .Features}}{{$fs := .Status}}
+<details class="feature node {{$fs}}" open>
+<summary class="{{$fs}}"><span class="badge">{{$fs}}</span> Feature: {{.Name}}</summary>
+{{range .Givens}}{{$gs := .Status}}
+<details class="given node {{$gs}}" {{if ne $gs.String "passed"}}open{{end}}>
+<summary class="{{$gs}}"><span class="badge">{{$gs}}</span> Given {{.Name}} <span class="duration">{{duration .Duration}}</span> <span class="location">{{.File}}:{{.Line}}</span></summary>
+{{range .Whens}}{{$ws := .Status}}
+<details class="when node {{$ws}}" open>
+<summary class="{{$ws}}"><span class="badge">{{$ws}}</span> When {{.Name}}</summary>
+{{range .Its}}
+<div class="it {{.Status}}" data-status="{{.Status}}" data-tags="{{join .Tags " "}}" data-text="{{lower .Path}}">
+<span class="badge">{{.Status}}</span> It {{.It}} <span class="duration">{{duration .Duration}}</span>{{range .Tags}} <span class="tag">{{.}}</span>{{end}}
+{{range .Failures}}{{$line := .Line}}
+<div class="failure">
+<div class="message">{{.Message}}</div>
+{{if .File}}<div class="location">in {{.Location}}</div>
+<pre class="excerpt">{{range .Excerpt}}<span{{if eq .Number $line}} class="error"{{end}}>{{.Number}}. {{.Text}}</span>
+{{end}}</pre>{{end}}
+</div>
+{{end}}
+</div>
+{{end}}
+</details>
+{{end}}
+</details>
+{{end}}
+</details>
+{{end}}
+<script>
+(function () {
+  var search = document.getElementById("search");
+  var tag = document.getElementById("tag");
+  var statuses = document.querySelectorAll("input.status");
+
+  function apply() {
+    var text = search.value.toLowerCase();
+    var allowed = {};
+    statuses.forEach(function (s) { allowed[s.value] = s.checked; });
+
+    document.querySelectorAll(".it").forEach(function (it) {
+      var visible = allowed[it.dataset.status] &&
+        (tag.value === "" || (" " + it.dataset.tags + " ").indexOf(" " + tag.value + " ") >= 0) &&
+        (text === "" || it.dataset.text.indexOf(text) >= 0);
+      it.classList.toggle("hidden", !visible);
+    });
+
+    ["when", "given", "feature"].forEach(function (level) {
+      document.querySelectorAll("details." + level).forEach(function (node) {
+        var its = node.querySelectorAll(".it");
+        var shown = node.querySelectorAll(".it:not(.hidden)");
+        node.classList.toggle("hidden", its.length > 0 && shown.length === 0);
+      });
+    });
+  }
+
+  function toggleAll(open) {
+    document.querySelectorAll("details").forEach(function (d) { d.open = open; });
+  }
+
+  search.addEventListener("input", apply);
+  tag.addEventListener("change", apply);
+  statuses.forEach(function (s) { s.addEventListener("change", apply); });
+  document.getElementById("expand").addEventListener("click", function () { toggleAll(true); });
+  document.getElementById("collapse").addEventListener("click", function () { toggleAll(false); });
+})();
+</script>
+</body>
+</html>
+`
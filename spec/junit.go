@@ -0,0 +1,193 @@
+package spec
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName xml.Name          `xml:"testsuites"`
+	Suites  []*junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite groups all test cases of a Feature.
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Skipped   int              `xml:"skipped,attr"`
+	Time      string           `xml:"time,attr"`
+	Timestamp string           `xml:"timestamp,attr"`
+	Cases     []*junitTestCase `xml:"testcase"`
+
+	duration time.Duration
+}
+
+// junitTestCase represents a single verification, for each It.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+// junitFailure details why a test case failed.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+// junitSkipped marks a test case not implemented.
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// junitReporter stores results by feature, and writes them as JUnit
+// XML to a file when closed.
+type junitReporter struct {
+	filename string
+	suites   map[string]*junitTestSuite
+	order    []string
+}
+
+// NewJUnitReporter creates a reporter writing a JUnit XML report on
+// filename, with one testsuite per Feature and one testcase per
+// verification. The file is written at the end of each test running
+// specifications, so no TestMain is needed, and a last time when
+// spec.Close() is called.
+//
+//    spec.AddReporter(spec.NewJUnitReporter("report.xml"))
+//
+// It's also enabled with -bdd.junit=report.xml flag or BDD_JUNIT
+// environment variable.
+func NewJUnitReporter(filename string) (r Reporter) {
+	r = newFileReporter(&junitReporter{
+		filename: filename,
+		suites:   make(map[string]*junitTestSuite),
+	})
+	return
+}
+
+// seconds formats duration as used on JUnit time attributes.
+func seconds(d time.Duration) (s string) {
+	s = fmt.Sprintf("%.3f", d.Seconds())
+	return
+}
+
+// suite returns the suite for feature of event, creating if needed.
+func (jr *junitReporter) suite(e Event) (s *junitTestSuite) {
+	var ok bool
+	if s, ok = jr.suites[e.Feature]; !ok {
+		s = &junitTestSuite{
+			Name:      e.Feature,
+			Timestamp: e.Started.Format("2006-01-02T15:04:05"),
+		}
+		jr.suites[e.Feature] = s
+		jr.order = append(jr.order, e.Feature)
+	}
+	return
+}
+
+// addCase includes a test case for the verification on event.
+func (jr *junitReporter) addCase(e Event) (c *junitTestCase) {
+	s := jr.suite(e)
+	c = &junitTestCase{
+		Name:      e.Path(),
+		ClassName: e.Feature,
+		Time:      seconds(e.Duration),
+	}
+
+	s.Cases = append(s.Cases, c)
+	s.Tests++
+	return
+}
+
+// FeatureStarted creates the suite for feature.
+func (jr *junitReporter) FeatureStarted(e Event) {
+	jr.suite(e)
+}
+
+// GivenStarted does nothing, since contexts are part of case names.
+func (jr *junitReporter) GivenStarted(e Event) {}
+
+// WhenStarted does nothing, since situations are part of case names.
+func (jr *junitReporter) WhenStarted(e Event) {}
+
+// ItPassed includes a successful test case.
+func (jr *junitReporter) ItPassed(e Event) {
+	jr.addCase(e)
+}
+
+// ItFailed includes a failed test case, with all failure messages.
+func (jr *junitReporter) ItFailed(e Event) {
+	c := jr.addCase(e)
+	jr.suite(e).Failures++
+
+	f := &junitFailure{Type: "assertion"}
+	for i, fl := range e.Failures {
+		if i == 0 {
+			f.Message = fl.Summary()
+		} else {
+			f.Content += "\n\n"
+		}
+		f.Content += fl.Details()
+	}
+
+	c.Failure = f
+}
+
+// ItPending includes a skipped test case.
+func (jr *junitReporter) ItPending(e Event) {
+	c := jr.addCase(e)
+	jr.suite(e).Skipped++
+	c.Skipped = &junitSkipped{Message: "NOT IMPLEMENTED"}
+}
+
+// ItSkipped includes a skipped test case.
+func (jr *junitReporter) ItSkipped(e Event) {
+	c := jr.addCase(e)
+	jr.suite(e).Skipped++
+	c.Skipped = &junitSkipped{Message: "SKIPPED"}
+}
+
+// GivenFinished adds context duration to its feature.
+func (jr *junitReporter) GivenFinished(e Event) {
+	s := jr.suite(e)
+	s.duration += e.Duration
+	s.Time = seconds(s.duration)
+}
+
+// Close writes the report.
+func (jr *junitReporter) Close() (err error) {
+	err = jr.write()
+	return
+}
+
+// write saves the whole report on file.
+func (jr *junitReporter) write() (err error) {
+	root := junitTestSuites{}
+	for _, name := range jr.order {
+		root.Suites = append(root.Suites, jr.suites[name])
+	}
+
+	var bytes []byte
+	if bytes, err = xml.MarshalIndent(root, "", "  "); err != nil {
+		return
+	}
+
+	if err = os.MkdirAll(filepath.Dir(jr.filename), 0755); err == nil {
+		err = ioutil.WriteFile(jr.filename, append([]byte(xml.Header), bytes...), 0644)
+	}
+
+	err = errors.Wrap(err, "failed to write JUnit report")
+	return
+}
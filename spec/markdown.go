@@ -0,0 +1,156 @@
+package spec
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// markdownMarkers are the symbols used for each status.
+	markdownMarkers = map[Status]string{
+		StatusPassed:  "✅",
+		StatusFailed:  "❌",
+		StatusPending: "⏳",
+		StatusSkipped: "⏭️",
+	}
+)
+
+// NewMarkdownReporter creates a reporter writing the specification
+// tree as GitHub-flavoured Markdown on filename, with a summary table
+// at the top and one heading per Feature. Verifications run from a
+// Like sentence are rendered as a table with their arguments. The
+// file is written at the end of each test running specifications, so
+// no TestMain is needed, and a last time when spec.Close() is called.
+//
+//    spec.AddReporter(spec.NewMarkdownReporter("SPECS.md"))
+//
+// It's also enabled with -bdd.markdown=SPECS.md flag or BDD_MARKDOWN
+// environment variable.
+func NewMarkdownReporter(filename string) (r Reporter) {
+	r = newFileReporter(newTreeReporter(func(st *specTree) (err error) {
+		if err = os.MkdirAll(filepath.Dir(filename), 0755); err == nil {
+			err = ioutil.WriteFile(filename, []byte(markdownOf(st)), 0644)
+		}
+
+		err = errors.Wrap(err, "failed to write Markdown report")
+		return
+	}))
+	return
+}
+
+// markdownOf renders the whole tree as Markdown.
+func markdownOf(st *specTree) (md string) {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# Specifications\n\n")
+	fmt.Fprintf(b, "| Feature | %s Passed | %s Failed | %s Pending | %s Skipped | Duration |\n",
+		markdownMarkers[StatusPassed], markdownMarkers[StatusFailed], markdownMarkers[StatusPending], markdownMarkers[StatusSkipped])
+	fmt.Fprintf(b, "| --- | ---: | ---: | ---: | ---: | ---: |\n")
+	for _, f := range st.Features {
+		fmt.Fprintf(b, "| %s %s | %d | %d | %d | %d | %s |\n", markdownMarkers[f.Status()], markdownCell(f.Name),
+			f.Counts["passed"], f.Counts["failed"], f.Counts["pending"], f.Counts["skipped"], f.Duration.Round(time.Microsecond))
+	}
+	fmt.Fprintf(b, "| **Total** | %d | %d | %d | %d | %s |\n",
+		st.Counts["passed"], st.Counts["failed"], st.Counts["pending"], st.Counts["skipped"], st.Duration.Round(time.Microsecond))
+
+	for _, f := range st.Features {
+		fmt.Fprintf(b, "\n## Feature: %s\n\n", f.Name)
+		for _, g := range f.Givens {
+			fmt.Fprintf(b, "- %s **Given** %s\n", markdownMarkers[g.Status()], markdownLine(g.Name))
+			for _, w := range g.Whens {
+				fmt.Fprintf(b, "  - %s **When** %s\n", markdownMarkers[w.Status()], markdownLine(w.Name))
+				for _, rows := range groupByLine(w.Its) {
+					if len(rows) > 1 {
+						writeMarkdownTable(b, rows, "      ")
+					} else {
+						fmt.Fprintf(b, "    - %s It %s\n", markdownMarkers[rows[0].Status], markdownLine(rows[0].It))
+						writeMarkdownFailures(b, rows[0], "      ", false)
+					}
+				}
+			}
+		}
+	}
+
+	md = b.String()
+	return
+}
+
+// groupByLine splits verifications in groups declared on the same
+// line, which are the rows of a Like sentence.
+func groupByLine(its []*itNode) (groups [][]*itNode) {
+	for i, it := range its {
+		if i > 0 && it.Line == its[i-1].Line && it.Line != 0 {
+			groups[len(groups)-1] = append(groups[len(groups)-1], it)
+		} else {
+			groups = append(groups, []*itNode{it})
+		}
+	}
+	return
+}
+
+// writeMarkdownTable writes verifications from a Like sentence as a
+// table, with a column for each argument.
+func writeMarkdownTable(b *strings.Builder, rows []*itNode, indent string) {
+	columns := 0
+	for _, r := range rows {
+		if len(r.Args) > columns {
+			columns = len(r.Args)
+		}
+	}
+
+	fmt.Fprintf(b, "    - Examples at line %d:\n\n", rows[0].Line)
+	fmt.Fprintf(b, "%s| | It |", indent)
+	for c := 1; c <= columns; c++ {
+		fmt.Fprintf(b, " %d |", c)
+	}
+	fmt.Fprintf(b, "\n%s| --- | --- |%s\n", indent, strings.Repeat(" --- |", columns))
+
+	for _, r := range rows {
+		fmt.Fprintf(b, "%s| %s | %s |", indent, markdownMarkers[r.Status], markdownCell(r.It))
+		for c := 0; c < columns; c++ {
+			cell := ""
+			if c < len(r.Args) {
+				cell = markdownCell(fmt.Sprintf("%v", r.Args[c]))
+			}
+			fmt.Fprintf(b, " %s |", cell)
+		}
+		fmt.Fprintln(b)
+	}
+	fmt.Fprintln(b)
+
+	for _, r := range rows {
+		writeMarkdownFailures(b, r, indent, true)
+	}
+}
+
+// writeMarkdownFailures writes details of each failure of verification
+// as a code block, labeled with the verification when asked.
+func writeMarkdownFailures(b *strings.Builder, it *itNode, indent string, labeled bool) {
+	for _, f := range it.Failures {
+		fmt.Fprintf(b, "\n%s```\n", indent)
+		if labeled {
+			fmt.Fprintf(b, "%sIt %s\n", indent, it.It)
+		}
+		for _, l := range strings.Split(f.Details(), "\n") {
+			fmt.Fprintf(b, "%s%s\n", indent, l)
+		}
+		fmt.Fprintf(b, "%s```\n\n", indent)
+	}
+}
+
+// markdownLine keeps text on a single line of Markdown.
+func markdownLine(text string) (r string) {
+	r = strings.Join(strings.Fields(text), " ")
+	return
+}
+
+// markdownCell escapes text to be used inside a table cell.
+func markdownCell(text string) (r string) {
+	r = strings.Replace(markdownLine(text), "|", "\\|", -1)
+	return
+}
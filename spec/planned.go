@@ -1,5 +1,9 @@
 package spec
 
+import (
+	"io"
+)
+
 // These functions are planned to change in new API.
 
 // SetVerbose is used to set the output to Stdout (default).
@@ -15,3 +19,20 @@ func SetVerbose() {
 func SetSilent() {
 	config.Output = OutputNone
 }
+
+// SetOutput is used to set where output is printed, with one of the
+// Output constants.
+//
+//    spec.SetOutput(spec.OutputTestLog)
+func SetOutput(o outputType) {
+	config.Output = o
+	config.detectColors()
+}
+
+// SetWriter is used to print all output on w.
+//
+//    spec.SetWriter(os.Stderr)
+func SetWriter(w io.Writer) {
+	config.Output, config.Writer = OutputWriter, w
+	config.detectColors()
+}
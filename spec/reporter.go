@@ -0,0 +1,333 @@
+package spec
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// StatusPassed marks a verification where all assertions succeeded.
+	StatusPassed Status = iota
+	// StatusFailed marks a verification with at least one failed
+	// assertion.
+	StatusFailed
+	// StatusPending marks a verification not implemented yet.
+	StatusPending
+	// StatusSkipped marks a verification not executed on purpose.
+	StatusSkipped
+)
+
+var (
+	// reporters stores all reporters receiving events from specifications.
+	reporters multiReporter
+)
+
+// Status tells the outcome of a verification.
+type Status int
+
+// String returns a readable name for status.
+func (s Status) String() (r string) {
+	switch s {
+	case StatusPassed:
+		r = "passed"
+	case StatusFailed:
+		r = "failed"
+	case StatusPending:
+		r = "pending"
+	case StatusSkipped:
+		r = "skipped"
+	default:
+		r = "unknown"
+	}
+	return
+}
+
+// SourceLine is a line of source code, surrounding a failure. On the
+// failing line, Start and End are the offsets of the failing
+// expression in Text, with End zero when unknown.
+type SourceLine struct {
+	Number int
+	Text   string
+	Start  int
+	End    int
+}
+
+// Failure describes an assertion that failed during a verification,
+// at Column of Line on File. Stack holds the chain of user calls
+// leading to it, from File and Line, the innermost one.
+type Failure struct {
+	Message string
+	File    string
+	Line    int
+	Column  int
+	Excerpt []SourceLine
+	Stack   []Frame
+}
+
+// Event holds information about the specification step being
+// reported. Fields not related to the step are left empty, so a
+// GivenStarted event has no When or It. File and Line tell where the
+// step was declared, and T is the test running it, when there's one.
+type Event struct {
+	Feature  string
+	Given    string
+	When     string
+	It       string
+	Args     []interface{}
+	File     string
+	Line     int
+	Started  time.Time
+	Duration time.Duration
+	Status   Status
+	Failures []Failure
+	T        *testing.T
+}
+
+// Reporter receives structured events while specifications run.
+// Register new reporters with spec.AddReporter(...).
+type Reporter interface {
+	// FeatureStarted is called when a new feature begins.
+	FeatureStarted(e Event)
+	// GivenStarted is called when a new context begins.
+	GivenStarted(e Event)
+	// WhenStarted is called when a new situation begins.
+	WhenStarted(e Event)
+	// ItPassed is called when a verification succeeds.
+	ItPassed(e Event)
+	// ItFailed is called when a verification fails, with Failures
+	// detailing each assertion failed.
+	ItFailed(e Event)
+	// ItPending is called when a verification is not implemented.
+	ItPending(e Event)
+	// ItSkipped is called when a verification is skipped on purpose.
+	ItSkipped(e Event)
+	// GivenFinished is called when a context ends, with its Duration.
+	GivenFinished(e Event)
+}
+
+// multiReporter broadcasts each event to a list of reporters.
+type multiReporter []Reporter
+
+// FeatureStarted broadcasts event to all reporters.
+func (mr multiReporter) FeatureStarted(e Event) {
+	for _, r := range mr {
+		r.FeatureStarted(e)
+	}
+}
+
+// GivenStarted broadcasts event to all reporters.
+func (mr multiReporter) GivenStarted(e Event) {
+	for _, r := range mr {
+		r.GivenStarted(e)
+	}
+}
+
+// WhenStarted broadcasts event to all reporters.
+func (mr multiReporter) WhenStarted(e Event) {
+	for _, r := range mr {
+		r.WhenStarted(e)
+	}
+}
+
+// ItPassed broadcasts event to all reporters.
+func (mr multiReporter) ItPassed(e Event) {
+	for _, r := range mr {
+		r.ItPassed(e)
+	}
+}
+
+// ItFailed broadcasts event to all reporters.
+func (mr multiReporter) ItFailed(e Event) {
+	for _, r := range mr {
+		r.ItFailed(e)
+	}
+}
+
+// ItPending broadcasts event to all reporters.
+func (mr multiReporter) ItPending(e Event) {
+	for _, r := range mr {
+		r.ItPending(e)
+	}
+}
+
+// ItSkipped broadcasts event to all reporters.
+func (mr multiReporter) ItSkipped(e Event) {
+	for _, r := range mr {
+		r.ItSkipped(e)
+	}
+}
+
+// GivenFinished broadcasts event to all reporters.
+func (mr multiReporter) GivenFinished(e Event) {
+	if e.T != nil {
+		e.T.Helper()
+	}
+
+	for _, r := range mr {
+		r.GivenFinished(e)
+	}
+}
+
+// Close closes every reporter implementing io.Closer, even when some
+// fail, returning an error telling every failure found.
+func (mr multiReporter) Close() (err error) {
+	var messages []string
+	for _, r := range mr {
+		if c, ok := r.(io.Closer); ok {
+			if cerr := c.Close(); cerr != nil {
+				messages = append(messages, cerr.Error())
+			}
+		}
+	}
+
+	if len(messages) > 0 {
+		err = errors.New(strings.Join(messages, "\n"))
+	}
+	return
+}
+
+// AddReporter registers a reporter to receive events, along with the
+// reporters already registered.
+//
+//    spec.AddReporter(myReporter)
+func AddReporter(r Reporter) {
+	reporters = append(reporters, r)
+}
+
+// SetReporters replaces all registered reporters with the ones given.
+// Calling it without arguments removes every reporter, including the
+// default console one.
+//
+//    spec.SetReporters(spec.NewConsoleReporter(), myReporter)
+func SetReporters(rs ...Reporter) {
+	reporters = append(multiReporter{}, rs...)
+}
+
+// Close informs reporters that all specifications have run, so the
+// ones implementing io.Closer can write anything left, like the plan
+// of a TAP stream or the summary of package. On strict mode, allowed
+// pending paths not matched by any specification are returned as an
+// error too. Call it on TestMain, after running tests, or use
+// spec.Main().
+//
+//    func TestMain(m *testing.M) {
+//        code := m.Run()
+//        spec.Close()
+//        os.Exit(code)
+//    }
+func Close() (err error) {
+	err = reporters.Close()
+	if stale := config.staleAllowedPending(); stale != nil {
+		if err != nil {
+			stale = errors.Errorf("%v\n%v", err, stale)
+		}
+		err = stale
+	}
+	return
+}
+
+// Reporters returns the reporters currently registered.
+func Reporters() (rs []Reporter) {
+	rs = append(rs, reporters...)
+	return
+}
+
+// Path returns the sentences leading to the step, like "Given a
+// context When an event It should do something".
+func (e Event) Path() (p string) {
+	var parts []string
+	if e.Given != "" {
+		parts = append(parts, "Given "+e.Given)
+	}
+	if e.When != "" {
+		parts = append(parts, "When "+e.When)
+	}
+	if e.It != "" {
+		parts = append(parts, "It "+e.It)
+	}
+
+	p = strings.Join(parts, " ")
+	return
+}
+
+// Tags returns the words starting with '@' on sentences of the step,
+// like "@slow" on "Given @slow a big file".
+func (e Event) Tags() (tags []string) {
+	for _, word := range strings.Fields(strings.Join([]string{e.Feature, e.Given, e.When, e.It}, " ")) {
+		if len(word) > 1 && strings.HasPrefix(word, "@") {
+			tags = append(tags, word)
+		}
+	}
+	return
+}
+
+// Summary returns the failure message in a single line, without
+// indentation.
+func (f Failure) Summary() (s string) {
+	s = strings.Join(strings.Fields(f.Message), " ")
+	return
+}
+
+// Location returns where failure happened as file:line:column, or
+// file:line without column, or empty if unknown.
+func (f Failure) Location() (l string) {
+	if f.File != "" {
+		l = fmt.Sprintf("%s:%d", f.File, f.Line)
+		if f.Column > 0 {
+			l += fmt.Sprintf(":%d", f.Column)
+		}
+	}
+	return
+}
+
+// callers returns the chain of user calls leading to the failing
+// line, without the failing line itself.
+func (f Failure) callers() (frames []Frame) {
+	if len(f.Stack) > 1 {
+		frames = f.Stack[1:]
+	}
+	return
+}
+
+// Details returns the failure message followed by its location,
+// excerpt of code and the chain of calls leading to it, without any
+// colors.
+func (f Failure) Details() (d string) {
+	lines := []string{strings.TrimSpace(f.Message)}
+	if l := f.Location(); l != "" {
+		lines = append(lines, "in "+l)
+		for _, sl := range f.Excerpt {
+			lines = append(lines, fmt.Sprintf("%d. %s", sl.Number, sl.Text))
+		}
+		for _, fr := range f.callers() {
+			lines = append(lines, fmt.Sprintf("called from %s:%d", fr.File, fr.Line))
+		}
+	}
+
+	d = strings.Join(lines, "\n")
+	return
+}
+
+// Main runs the tests of package, then closes reporters with
+// spec.Close(), returning the exit code. A failure closing reporters
+// fails the run.
+//
+//    func TestMain(m *testing.M) {
+//        os.Exit(spec.Main(m))
+//    }
+func Main(m *testing.M) (code int) {
+	code = m.Run()
+	if err := Close(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		if code == 0 {
+			code = 1
+		}
+	}
+	return
+}
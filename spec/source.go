@@ -0,0 +1,163 @@
+package spec
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ddsgok/bdd/colors"
+)
+
+var (
+	// moduleRoots caches the module root found for each directory.
+	moduleRoots sync.Map
+)
+
+// moduleRoot returns the directory holding go.mod of module containing
+// dir, or empty when there's none.
+func moduleRoot(dir string) (root string) {
+	if r, ok := moduleRoots.Load(dir); ok {
+		root = r.(string)
+		return
+	}
+
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			root = d
+			break
+		}
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+
+	moduleRoots.Store(dir, root)
+	return
+}
+
+// moduleRelative returns file relative to root of its module, or
+// relative to working directory when it's not in a module.
+func moduleRelative(file string) (r string) {
+	r = relativePath(file)
+	if root := moduleRoot(filepath.Dir(file)); root != "" {
+		if rel, err := filepath.Rel(root, file); err == nil {
+			r = filepath.ToSlash(rel)
+		}
+	}
+	return
+}
+
+// failingSpan returns the column of the outermost call starting on
+// line ln of src, and the column where it ends on that line, both
+// counted in bytes from 1. Without a call, it returns the first column
+// not blank and no end.
+func failingSpan(filename string, src []byte, ln int) (col, end int) {
+	lines := strings.Split(string(src), "\n")
+	if ln < 1 || ln > len(lines) {
+		return
+	}
+	line := lines[ln-1]
+
+	fset := token.NewFileSet()
+	if file, err := parser.ParseFile(fset, filename, src, 0); err == nil {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if col > 0 || n == nil {
+				return false
+			}
+			if ok && fset.Position(call.Pos()).Line == ln {
+				col = fset.Position(call.Pos()).Column
+				if e := fset.Position(call.End()); e.Line == ln {
+					end = e.Column
+				} else {
+					end = len(strings.TrimRight(line, " \t")) + 1
+				}
+				return false
+			}
+			return true
+		})
+	}
+
+	if col == 0 {
+		col = len(line) - len(strings.TrimLeft(line, " \t")) + 1
+	}
+	return
+}
+
+// syntaxOf returns the ansi code of each byte of text, a line of Go
+// code, by the kind of token it's part of.
+func syntaxOf(text string) (codes []string) {
+	codes = make([]string, len(text))
+	for i := range codes {
+		codes[i] = config.AnsiOfCode
+	}
+
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(text))
+
+	var s scanner.Scanner
+	s.Init(file, []byte(text), func(token.Position, string) {}, scanner.ScanComments)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+
+		code := ""
+		switch {
+		case tok == token.COMMENT:
+			code = config.AnsiOfComment
+		case tok == token.STRING || tok == token.CHAR:
+			code = config.AnsiOfString
+		case tok == token.INT || tok == token.FLOAT || tok == token.IMAG:
+			code = config.AnsiOfNumber
+		case tok.IsKeyword():
+			code = config.AnsiOfKeyword
+		}
+
+		if code != "" {
+			start := file.Offset(pos)
+			length := len(lit)
+			if lit == "" {
+				length = len(tok.String())
+			}
+			for i := start; i < start+length && i < len(codes); i++ {
+				codes[i] = code
+			}
+		}
+	}
+	return
+}
+
+// highlight returns text, a line of Go code, colored by syntax, with
+// bytes from start to end underlined, when end is set.
+func highlight(text string, start, end int) (s string) {
+	if config.AnsiOfCode == "" {
+		s = text
+		return
+	}
+
+	codes := syntaxOf(text)
+	b := &strings.Builder{}
+	current := ""
+	for i := 0; i < len(text); i++ {
+		code := codes[i]
+		if i >= start && i < end {
+			code += colors.Underline
+		}
+		if code != current {
+			b.WriteString(colors.Reset + code)
+			current = code
+		}
+		b.WriteByte(text[i])
+	}
+	b.WriteString(colors.Reset)
+
+	s = b.String()
+	return
+}
@@ -3,25 +3,13 @@ package spec
 import (
 	"fmt"
 	"io/ioutil"
-	"path"
-	"runtime"
 	"strings"
 	"testing"
+	"time"
 
-	"github.com/ddsgok/bdd/colors"
 	"github.com/ddsgok/bdd/internal/common"
 )
 
-// failingLineData stores information about error, captured on assert
-// sentence. It focus on describing the 3 lines, centered on error.
-type failingLineData struct {
-	prev     string
-	content  string
-	next     string
-	filename string
-	number   int
-}
-
 // TestSpecification holds the state of the test context for a specific specification.
 type TestSpecification struct {
 	T                       *testing.T
@@ -29,137 +17,273 @@ type TestSpecification struct {
 	Given                   string
 	When                    string
 	It                      string
+	Args                    []interface{}
+	File                    string
+	GivenLine               int
+	WhenLine                int
+	ItLine                  int
 	AssertFn                func(common.Assert)
 	AssertionFailed         bool
+	// AssertionFailedMessages holds the messages of failures of the
+	// last verification run, one for each failure reported, kept until
+	// the next one runs or the context finishes.
 	AssertionFailedMessages []string
 
 	NotImplemented bool
+	Skipped        bool
+
+	started    time.Time
+	itStarted  time.Time
+	itDuration time.Duration
+	failures   []Failure
+}
+
+// event returns an Event describing current state of specification.
+func (spec *TestSpecification) event() (e Event) {
+	e = Event{
+		Feature: spec.Feature,
+		Given:   spec.Given,
+		When:    spec.When,
+		It:      spec.It,
+		Args:    spec.Args,
+		File:    spec.File,
+		Line:    spec.ItLine,
+		Started: spec.started,
+		T:       spec.T,
+	}
+	return
 }
 
-// PrintFeature prints line informing about feature being tested.
+// PrintFeature informs reporters about feature being tested.
 func (spec *TestSpecification) PrintFeature() {
 	if config.LastFeature != spec.Feature {
-		if config.Output != OutputNone {
-			fmt.Printf("%sFeature: %s%s\n", config.AnsiOfFeature, spec.Feature, colors.Reset)
-		}
+		reporters.FeatureStarted(Event{Feature: spec.Feature, File: spec.File, Line: spec.GivenLine, Started: spec.started, T: spec.T})
 		config.LastFeature = spec.Feature
 	}
 
 	config.ResetLasts()
 }
 
-// PrintContext prints line informing about context being tested.
+// PrintContext informs reporters about context being tested.
 func (spec *TestSpecification) PrintContext() {
 	if config.LastGiven != spec.Given {
-		if config.Output != OutputNone {
-			fmt.Printf("%s  Given %s%s\n", config.AnsiOfGiven, withLeftPadding(spec.Given, 2), colors.Reset)
-		}
+		reporters.GivenStarted(Event{Feature: spec.Feature, Given: spec.Given, File: spec.File, Line: spec.GivenLine, Started: spec.started, T: spec.T})
 		config.LastGiven = spec.Given
 	}
 
 	config.ResetWhen()
 }
 
-// PrintWhen prints line informing about situation being tested.
+// PrintWhen informs reporters about situation being tested.
 func (spec *TestSpecification) PrintWhen() {
 	if config.LastWhen != spec.When {
-		if config.Output != OutputNone {
-			fmt.Printf("%s    When %s%s\n", config.AnsiOfWhen, spec.When, colors.Reset)
-		}
+		reporters.WhenStarted(Event{Feature: spec.Feature, Given: spec.Given, When: spec.When, File: spec.File, Line: spec.WhenLine, Started: time.Now(), T: spec.T})
 		config.LastWhen = spec.When
 	}
 
 	config.ResetIt()
 }
 
-// PrintIt prints line informing about verification being tested when
+// itEvent returns an Event describing the verification that ran last.
+func (spec *TestSpecification) itEvent(status Status) (e Event) {
+	e = spec.event()
+	e.Started = spec.itStarted
+	e.Duration = spec.itDuration
+	e.Status = status
+	return
+}
+
+// PrintIt informs reporters about verification being tested when
 // successful.
 func (spec *TestSpecification) PrintIt() {
-	if config.Output != OutputNone {
-		fmt.Printf("%s    » It %s %s\n", config.AnsiOfThen, spec.It, colors.Reset)
-	}
+	reporters.ItPassed(spec.itEvent(StatusPassed))
 	config.LastIt = spec.It
 }
 
-// PrintItWithError prints line informing about verification being
-// tested when verification fail.
+// PrintItWithError informs reporters about verification being tested
+// when verification fail, along with all failures registered.
 func (spec *TestSpecification) PrintItWithError() {
-	if config.Output != OutputNone {
-		fmt.Printf("%s    » It %s %s\n", config.AnsiOfThenWithError, spec.It, colors.Reset)
-	}
+	e := spec.itEvent(StatusFailed)
+	e.Failures = spec.failures
+	reporters.ItFailed(e)
 	config.LastIt = spec.It
 }
 
-// PrintItNotImplemented prints line informing about verification not
+// PrintItNotImplemented informs reporters about verification not
 // implemented.
 func (spec *TestSpecification) PrintItNotImplemented() {
-	if config.Output != OutputNone {
-		fmt.Printf("%s    » It %s «-- NOT IMPLEMENTED%s\n", config.AnsiOfThenNotImplemented, spec.It, colors.Reset)
-	}
+	reporters.ItPending(spec.itEvent(StatusPending))
+	config.LastIt = spec.It
+}
+
+// PrintItSkipped informs reporters about verification skipped.
+func (spec *TestSpecification) PrintItSkipped() {
+	reporters.ItSkipped(spec.itEvent(StatusSkipped))
 	config.LastIt = spec.It
 }
 
-// PrintError prints text detailing how the verification failed on
-// test.
+// PrintError registers text detailing how the verification failed on
+// test, together with the excerpt of code where it failed. Reporters
+// receive it when the verification ends, and its text is kept on
+// AssertionFailedMessages.
 func (spec *TestSpecification) PrintError(message string) {
-	if failingLine, err := failingLine(); err == nil && config.Output != OutputNone {
-		fmt.Printf("%s%s%s\n", config.AnsiOfExpectedError, message, colors.Reset)
-		fmt.Printf("%s        in %s:%d%s\n", config.AnsiOfCode, path.Base(failingLine.filename), failingLine.number, colors.Reset)
-		fmt.Printf("%s        ---------\n", config.AnsiOfCode)
-		fmt.Printf("%s        %d. %s%s\n", config.AnsiOfCode, failingLine.number-1, withSoftTabs(failingLine.prev), colors.Reset)
-		fmt.Printf("%s        %d. %s %s\n", config.AnsiOfCodeError, failingLine.number, failingLine.content, colors.Reset)
-		fmt.Printf("%s        %d. %s%s\n", config.AnsiOfCode, failingLine.number+1, withSoftTabs(failingLine.next), colors.Reset)
-		fmt.Println()
-		spec.T.Fail()
-		fmt.Println()
+	f := Failure{Message: message}
+	if fl, err := failingLine(); err == nil {
+		f.File, f.Line, f.Column, f.Excerpt, f.Stack = fl.File, fl.Line, fl.Column, fl.Excerpt, fl.Stack
 	}
+
+	spec.addFailure(f)
+}
+
+// addFailure registers failure f, along with its message, so failures
+// and AssertionFailedMessages always match.
+func (spec *TestSpecification) addFailure(f Failure) {
+	spec.failures = append(spec.failures, f)
+	spec.AssertionFailedMessages = append(spec.AssertionFailedMessages, f.Message)
+}
+
+// takeFailures removes the failures registered after the first start
+// ones, along with their messages, returning them.
+func (spec *TestSpecification) takeFailures(start int) (taken []Failure) {
+	taken = append(taken, spec.failures[start:]...)
+	spec.failures = spec.failures[:start]
+	spec.AssertionFailedMessages = spec.AssertionFailedMessages[:start]
+	return
 }
 
 // Run handles contextual printing and some delegation
 // to the Assert's implementation for error handling
 func (spec *TestSpecification) Run() {
+	spec.AssertionFailed = false
+	spec.AssertionFailedMessages = nil
+	spec.failures = nil
+	spec.itStarted = time.Now()
 
 	// execute the Assertion
-	spec.AssertFn(config.assertFn(spec))
+	spec.runAssertFn()
+	spec.itDuration = time.Since(spec.itStarted)
 
-	// if there was no error (which handles its own printing),
-	// print the spec here.
-	if spec.NotImplemented {
+	// errors are kept until here, so reporters receive the whole
+	// verification at once.
+	if spec.Skipped {
+		spec.PrintItSkipped()
+	} else if spec.NotImplemented && !config.strictFails(spec.itEvent(StatusFailed).Path()) {
 		spec.PrintItNotImplemented()
-	} else if !spec.AssertionFailed {
+	} else if spec.NotImplemented {
+		spec.failStrict()
+		spec.PrintItWithError()
+		if spec.T != nil {
+			spec.T.Fail()
+		}
+	} else if spec.AssertionFailed {
+		spec.PrintItWithError()
+		if spec.T != nil {
+			spec.T.Fail()
+		}
+	} else {
 		spec.PrintIt()
 	}
+}
+
+// runAssertFn runs the verification, until its end or the first
+// failed assertion of Require(), that stops it.
+func (spec *TestSpecification) runAssertFn() {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, aborted := r.(abortedIt); !aborted {
+				panic(r)
+			}
+		}
+	}()
+
+	spec.AssertFn(config.assertFn(spec))
+}
+
+// Undefined informs the context, or its current situation, has no
+// body. On strict mode, it's reported as a failed verification, unless
+// allowed. Otherwise, nothing is reported.
+func (spec *TestSpecification) Undefined() {
+	if !config.Strict {
+		return
+	}
+
+	line := spec.GivenLine
+	if spec.When != "" {
+		line = spec.WhenLine
+	}
+
+	spec.It, spec.Args, spec.ItLine, spec.Skipped = "", nil, line, false
+	spec.AssertFn = func(common.Assert) {}
+	spec.NotImplemented = true
+	spec.Run()
+}
+
+// failStrict registers the failure of a verification not implemented,
+// or undefined, on strict mode.
+func (spec *TestSpecification) failStrict() {
+	message := "NOT IMPLEMENTED: failing on strict mode"
+	if spec.It == "" {
+		message = "UNDEFINED: no body, failing on strict mode"
+	}
+
+	f, _ := excerptAt(spec.File, spec.ItLine)
+	f.Message = message
+	spec.addFailure(f)
+}
+
+// Finish informs reporters the context being tested has ended, and
+// makes config ready to print information about another context.
+func (spec *TestSpecification) Finish() {
+	if spec.T != nil {
+		spec.T.Helper()
+	}
+
+	e := spec.event()
+	e.When, e.It, e.Args, e.Line = "", "", nil, spec.GivenLine
+	e.Duration = time.Since(spec.started)
+	reporters.GivenFinished(e)
 
 	spec.AssertionFailed = false
+	spec.AssertionFailedMessages = nil
+	spec.failures = nil
+	config.ResetLasts()
 }
 
+// New creates a specification for a context on a feature, starting
+// its clock.
 func New(t *testing.T, feat, given string) (sp *TestSpecification) {
+	applyFlags()
+	reportFlagsError(t)
+	trackSkipped(t)
+
 	sp = &TestSpecification{
 		T:       t,
 		Feature: feat,
 		Given:   given,
+		started: time.Now(),
 	}
 	return
 }
 
-// failingLine returns information about current failing line on test.
-func failingLine() (fl failingLineData, err error) {
-	fl = failingLineData{}
-
-	// this entire func is now a hack because of where it is being called,
-	// which is now one caller higher.  previously it was being called in the
-	// Expect struct which had the right caller info.  but now, it is being
-	// called after the Assertion has been executed to print details to the
-	// string.
+// failingLine returns information about current failing line on test,
+// the innermost call made by user code, along with the chain of user
+// calls leading to it.
+func failingLine() (fl Failure, err error) {
+	frames := userFrames()
+	if len(frames) == 0 {
+		err = fmt.Errorf("failed to find the failing line")
+		return
+	}
 
-	_, filename, ln, _ := runtime.Caller(6)
+	fl, err = excerptAt(frames[0].File, frames[0].Line)
+	fl.Stack = frames
+	return
+}
 
-	// this is really hacky, need to find a way of not using magic numbers for runtime.Caller
-	// If we are not in a test file, we must still be inside this package,
-	// so we need to go up one more stack frame to get to the test file
-	if !strings.HasSuffix(filename, "_test.go") {
-		_, filename, ln, _ = runtime.Caller(7)
-	}
+// excerptAt returns a failure located at line ln of filename, with the
+// excerpt of code around it, as long as configured.
+func excerptAt(filename string, ln int) (fl Failure, err error) {
+	fl = Failure{File: filename, Line: ln}
 
 	bf, err := ioutil.ReadFile(filename)
 
@@ -168,14 +292,22 @@ func failingLine() (fl failingLineData, err error) {
 		return
 	}
 
-	lines := strings.Split(string(bf), "\n")[ln-2 : ln+2]
+	lines := strings.Split(string(bf), "\n")
+	col, end := failingSpan(filename, bf, ln)
+	if ln > 0 && ln <= len(lines) {
+		fl.Column = col
+	}
 
-	fl = failingLineData{
-		prev:     withSoftTabs(lines[0]),
-		content:  withSoftTabs(lines[1]),
-		next:     withSoftTabs(lines[2]),
-		filename: filename,
-		number:   ln,
+	around := config.contextLines()
+	for n := ln - around; around >= 0 && n <= ln+around; n++ {
+		if n > 0 && n <= len(lines) {
+			sl := SourceLine{Number: n, Text: withSoftTabs(lines[n-1])}
+			if n == ln && end > col {
+				sl.Start = len(withSoftTabs(lines[n-1][:col-1]))
+				sl.End = len(withSoftTabs(lines[n-1][:end-1]))
+			}
+			fl.Excerpt = append(fl.Excerpt, sl)
+		}
 	}
 	return
 }
@@ -0,0 +1,157 @@
+package spec
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// allowedUsed holds the allowed pending paths, normalized, matched
+	// by a specification, so stale ones can be told.
+	allowedUsed sync.Map
+	// skipsTracked holds the tests running specifications, checked for
+	// being skipped when they end.
+	skipsTracked sync.Map
+	// testsSkipped is not zero when a test running specifications was
+	// skipped, since Close.
+	testsSkipped int32
+)
+
+// SetStrict turns strict mode on, or off. On strict mode, verifications
+// not implemented, and contexts or situations without body, fail the
+// test, unless their paths are allowed on configuration.
+//
+// It's also turned on with -bdd.strict flag or BDD_STRICT=true
+// environment variable.
+func SetStrict(strict bool) {
+	config.Strict = strict
+}
+
+// LoadAllowedPending reads the paths allowed to be pending on strict
+// mode from filename, one per line, like:
+//
+//    # known pending specifications
+//    Given a dog When washing the dog It should smell good
+//    Given an undefined context
+//
+// Blank lines and lines starting with # are ignored. Paths not matching
+// any specification are told by spec.Close(), so the file is kept up to
+// date, unless some specifications may not have run. It's also loaded
+// with -bdd.allow-pending=file flag or BDD_ALLOW_PENDING environment
+// variable, failing the first test when it can't be read.
+func LoadAllowedPending(filename string) (err error) {
+	var f *os.File
+	if f, err = os.Open(filename); err != nil {
+		err = errors.Wrap(err, "failed to read allowed pending specifications")
+		return
+	}
+	defer f.Close()
+
+	var paths []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			paths = append(paths, line)
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = errors.Wrap(err, "failed to read allowed pending specifications")
+		return
+	}
+
+	config.AllowedPending = paths
+	return
+}
+
+// strictFails tells if a pending verification on path must fail, for
+// being on strict mode and not allowed.
+func (c *Configuration) strictFails(path string) (b bool) {
+	if !c.Strict {
+		return
+	}
+
+	path = normalizedPath(path)
+	for _, allowed := range c.AllowedPending {
+		if normalizedPath(allowed) == path {
+			allowedUsed.Store(path, true)
+			return
+		}
+	}
+
+	b = true
+	return
+}
+
+// trackSkipped checks, at the end of test t, if it was skipped, as its
+// specifications may not have run. Unnamed tests, like a zero
+// testing.T, never end, so they're left out.
+func trackSkipped(t *testing.T) {
+	if t == nil || t.Name() == "" {
+		return
+	}
+
+	if _, tracked := skipsTracked.LoadOrStore(t, true); !tracked {
+		t.Cleanup(func() {
+			skipsTracked.Delete(t)
+			if t.Skipped() {
+				atomic.StoreInt32(&testsSkipped, 1)
+			}
+		})
+	}
+}
+
+// flagSet tells if test flag name is set to other than its default.
+func flagSet(name string) (b bool) {
+	if f := flag.Lookup(name); f != nil {
+		b = f.Value.String() != f.DefValue
+	}
+	return
+}
+
+// staleAllowedPending returns an error listing the allowed pending
+// paths not matched by any specification, on strict mode. Nothing is
+// told when -test.run or -test.skip selects only some tests, as the
+// others could match them. When some specifications may not have run,
+// for a test skipped, -test.short or -test.failfast, they're only
+// warned on Stderr. Tests skipped before running any specification
+// can't be seen, so run them all before trusting the error.
+func (c *Configuration) staleAllowedPending() (err error) {
+	skipped := atomic.SwapInt32(&testsSkipped, 0) != 0
+	if !c.Strict || flagSet("test.run") || flagSet("test.skip") {
+		return
+	}
+
+	var stale []string
+	for _, allowed := range c.AllowedPending {
+		if _, used := allowedUsed.Load(normalizedPath(allowed)); !used {
+			stale = append(stale, "  "+allowed)
+		}
+	}
+
+	if len(stale) == 0 {
+		return
+	}
+
+	err = errors.Errorf("allowed pending specifications not found, remove them from allow-list:\n%s", strings.Join(stale, "\n"))
+	if skipped || flagSet("test.short") || flagSet("test.failfast") {
+		fmt.Fprintf(os.Stderr, "bdd: warning, some specifications may not have run, %v\n", err)
+		err = nil
+	}
+	return
+}
+
+// normalizedPath returns path with its words separated by single
+// spaces.
+func normalizedPath(path string) (s string) {
+	s = strings.Join(strings.Fields(path), " ")
+	return
+}
@@ -0,0 +1,249 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ddsgok/bdd/colors"
+)
+
+const (
+	// slowestCount is how many of the slowest verifications are listed
+	// on a summary.
+	slowestCount = 5
+)
+
+// summaryReporter builds a specification tree for each named test
+// function, and another for the whole package. The summary of a test is
+// printed when it ends, and the one of package when reporters are
+// closed.
+type summaryReporter struct {
+	all   *specTree
+	tests map[*testing.T]*specTree
+}
+
+// NewSummaryReporter creates a reporter printing a summary at the end
+// of each test function, and another one for the whole package when
+// spec.Close() is called, as done by spec.Main(). Each summary counts
+// verifications by status for each Feature, with total duration, and
+// lists the slowest and failed verifications.
+//
+// On quiet verbosity, summaries are only printed when a verification
+// fails. Tests without a name, like a zero testing.T, have no summary
+// of their own.
+//
+// It's registered by default, unless -bdd.summary=false flag or
+// BDD_SUMMARY=false environment variable is set.
+func NewSummaryReporter() (r Reporter) {
+	r = &summaryReporter{
+		all:   newSpecTree(),
+		tests: make(map[*testing.T]*specTree),
+	}
+	return
+}
+
+// trees returns the trees event is part of, creating the one of its
+// test when needed. Unnamed tests are never cleaned up, so only the
+// tree of package is used for them.
+func (sr *summaryReporter) trees(e Event) (sts []*specTree) {
+	sts = append(sts, sr.all)
+	if e.T == nil || e.T.Name() == "" {
+		return
+	}
+
+	st, ok := sr.tests[e.T]
+	if !ok {
+		t := e.T
+		st = newSpecTree()
+		sr.tests[t] = st
+		t.Cleanup(func() {
+			sr.printTest(t, st)
+			delete(sr.tests, t)
+		})
+	}
+
+	sts = append(sts, st)
+	return
+}
+
+// FeatureStarted creates the feature.
+func (sr *summaryReporter) FeatureStarted(e Event) {
+	for _, st := range sr.trees(e) {
+		st.feature(e)
+	}
+}
+
+// GivenStarted creates a new context.
+func (sr *summaryReporter) GivenStarted(e Event) {
+	for _, st := range sr.trees(e) {
+		st.startGiven(e)
+	}
+}
+
+// WhenStarted creates a new situation.
+func (sr *summaryReporter) WhenStarted(e Event) {
+	for _, st := range sr.trees(e) {
+		st.startWhen(e)
+	}
+}
+
+// ItPassed includes a passed verification.
+func (sr *summaryReporter) ItPassed(e Event) {
+	sr.addIt(e)
+}
+
+// ItFailed includes a failed verification.
+func (sr *summaryReporter) ItFailed(e Event) {
+	sr.addIt(e)
+}
+
+// ItPending includes a pending verification.
+func (sr *summaryReporter) ItPending(e Event) {
+	sr.addIt(e)
+}
+
+// ItSkipped includes a skipped verification.
+func (sr *summaryReporter) ItSkipped(e Event) {
+	sr.addIt(e)
+}
+
+// addIt includes verification on every tree of event.
+func (sr *summaryReporter) addIt(e Event) {
+	for _, st := range sr.trees(e) {
+		st.addIt(e)
+	}
+}
+
+// GivenFinished stores context duration.
+func (sr *summaryReporter) GivenFinished(e Event) {
+	for _, st := range sr.trees(e) {
+		st.finishGiven(e)
+	}
+}
+
+// Close prints the summary of whole package.
+func (sr *summaryReporter) Close() (err error) {
+	if sr.shown(sr.all) {
+		_, err = io.WriteString(config.writer(), summaryOf("all specifications", sr.all))
+	}
+	return
+}
+
+// printTest prints the summary of test t, through t.Log when output
+// is set to OutputTestLog.
+func (sr *summaryReporter) printTest(t *testing.T, st *specTree) {
+	if !sr.shown(st) {
+		return
+	}
+
+	summary := summaryOf(t.Name(), st)
+	if config.Output == OutputTestLog {
+		t.Log("\n" + strings.TrimRight(summary, "\n"))
+	} else {
+		_, _ = io.WriteString(config.writer(), summary)
+	}
+}
+
+// shown tells if the summary of tree is printed, according to output
+// and verbosity.
+func (sr *summaryReporter) shown(st *specTree) (b bool) {
+	switch {
+	case len(st.Features) == 0 || config.Output == OutputNone:
+	case config.Verbosity == VerbosityQuiet:
+		b = st.Counts[StatusFailed.String()] > 0
+	default:
+		b = true
+	}
+	return
+}
+
+// summaryOf renders the summary of tree, named title.
+func summaryOf(title string, st *specTree) (s string) {
+	b := &bytes.Buffer{}
+
+	var its []*itNode
+	width := len("Feature")
+	for _, f := range st.Features {
+		if w := colors.Width(f.Name); w > width {
+			width = w
+		}
+		for _, g := range f.Givens {
+			its = append(its, g.its()...)
+		}
+	}
+
+	noun := "verifications"
+	if len(its) == 1 {
+		noun = "verification"
+	}
+	fmt.Fprintln(b, paint(config.AnsiOfFeature, fmt.Sprintf("Summary of %s: %d %s in %s", title, len(its), noun, st.Duration.Round(time.Microsecond))))
+	fmt.Fprintf(b, "  %s %8s %8s %8s %8s %10s\n", colors.PadRight("Feature", width), "Passed", "Failed", "Pending", "Skipped", "Duration")
+	for _, f := range st.Features {
+		writeSummaryRow(b, f.Name, width, f.Counts, f.Duration)
+	}
+	writeSummaryRow(b, "Total", width, st.Counts, st.Duration)
+
+	slowest := make([]*itNode, 0, len(its))
+	for _, it := range its {
+		if it.Status == StatusPassed || it.Status == StatusFailed {
+			slowest = append(slowest, it)
+		}
+	}
+	sort.SliceStable(slowest, func(i, j int) bool {
+		return slowest[i].Duration > slowest[j].Duration
+	})
+	if len(slowest) > slowestCount {
+		slowest = slowest[:slowestCount]
+	}
+
+	if len(slowest) > 0 {
+		fmt.Fprintln(b, "\n  Slowest verifications:")
+		for _, it := range slowest {
+			fmt.Fprintf(b, "    %10s  %s\n", it.Duration.Round(time.Microsecond), markdownLine(it.Path()))
+		}
+	}
+
+	var failed []*itNode
+	for _, it := range its {
+		if it.Status == StatusFailed {
+			failed = append(failed, it)
+		}
+	}
+
+	if len(failed) > 0 {
+		fmt.Fprintln(b, "\n  Failed verifications:")
+		for _, it := range failed {
+			line := fmt.Sprintf("    %s %s", mark(config.MarkOfThenWithError), markdownLine(it.Path()))
+			fmt.Fprintf(b, "%s  at %s:%d\n", paint(config.AnsiOfExpectedError, line), relativePath(it.File), it.Line)
+		}
+	}
+
+	fmt.Fprintln(b)
+	s = b.String()
+	return
+}
+
+// writeSummaryRow writes counts of a feature, or total, as a row of
+// the summary table.
+func writeSummaryRow(b *bytes.Buffer, name string, width int, counts map[string]int, d time.Duration) {
+	count := func(status Status, ansi string) (s string) {
+		s = fmt.Sprintf("%d", counts[status.String()])
+		if counts[status.String()] > 0 {
+			s = paint(ansi, s)
+		}
+		s = colors.PadLeft(s, 8)
+		return
+	}
+
+	fmt.Fprintf(b, "  %s %s %s %s %s %10s\n", colors.PadRight(name, width),
+		count(StatusPassed, config.AnsiOfThen),
+		count(StatusFailed, config.AnsiOfExpectedError),
+		count(StatusPending, config.AnsiOfThenNotImplemented),
+		count(StatusSkipped, config.AnsiOfThenNotImplemented),
+		d.Round(time.Microsecond))
+}
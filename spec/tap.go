@@ -0,0 +1,183 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// tapReporter numbers each verification as a TAP test point. When
+// streaming, points are written as they happen and the plan only on
+// Close. Otherwise, the whole file is written on Close, with the plan
+// at the top.
+type tapReporter struct {
+	out      io.Writer
+	filename string
+	points   bytes.Buffer
+	count    int
+	started  bool
+}
+
+// NewTAPReporter creates a reporter writing a TAP version 13 report
+// on filename, where each verification is a numbered test point named
+// by its Given/When/It path. Not implemented verifications are marked
+// as TODO, skipped ones as SKIP, and failures have a YAML diagnostic
+// block. The file is written at the end of each test running
+// specifications, so no TestMain is needed, and a last time when
+// spec.Close() is called.
+//
+//    spec.AddReporter(spec.NewTAPReporter("specs.tap"))
+//
+// It's also enabled with -bdd.tap=specs.tap flag or BDD_TAP
+// environment variable.
+func NewTAPReporter(filename string) (r Reporter) {
+	r = newFileReporter(&tapReporter{filename: filename})
+	return
+}
+
+// NewTAPStreamReporter creates a reporter streaming TAP version 13 to
+// w, as verifications run. The plan is written at the end, when
+// spec.Close() is called.
+//
+//    spec.SetReporters(spec.NewTAPStreamReporter(os.Stdout))
+func NewTAPStreamReporter(w io.Writer) (r Reporter) {
+	r = &tapReporter{out: w}
+	return
+}
+
+// tapDescription escapes text to be used as description of a point.
+func tapDescription(text string) (r string) {
+	r = strings.Replace(strings.Join(strings.Fields(text), " "), "#", "\\#", -1)
+	return
+}
+
+// tapBlock writes text as a YAML literal block scalar for key.
+func tapBlock(w io.Writer, indent, key, text string) {
+	fmt.Fprintf(w, "%s%s: |\n", indent, key)
+	for _, l := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		fmt.Fprintf(w, "%s  %s\n", indent, l)
+	}
+}
+
+// tapFailure writes the diagnostic data of failure.
+func tapFailure(w io.Writer, indent string, f Failure) {
+	tapBlock(w, indent, "message", strings.TrimSpace(f.Message))
+	if f.File != "" {
+		fmt.Fprintf(w, "%sfile: %q\n", indent, f.File)
+		fmt.Fprintf(w, "%sline: %d\n", indent, f.Line)
+
+		var excerpt []string
+		for _, l := range f.Excerpt {
+			excerpt = append(excerpt, fmt.Sprintf("%d. %s", l.Number, l.Text))
+		}
+		tapBlock(w, indent, "excerpt", strings.Join(excerpt, "\n"))
+	}
+}
+
+// header writes the TAP version on stream, once.
+func (tr *tapReporter) header() {
+	if !tr.started {
+		fmt.Fprintln(tr.out, "TAP version 13")
+		tr.started = true
+	}
+}
+
+// point writes a test point for verification on event.
+func (tr *tapReporter) point(e Event, ok bool, directive string) {
+	tr.count++
+	w := &bytes.Buffer{}
+
+	status := "ok"
+	if !ok {
+		status = "not ok"
+	}
+
+	fmt.Fprintf(w, "%s %d - %s", status, tr.count, tapDescription(e.Path()))
+	if directive != "" {
+		fmt.Fprintf(w, " # %s", directive)
+	}
+	fmt.Fprintln(w)
+
+	if len(e.Failures) > 0 {
+		fmt.Fprintln(w, "  ---")
+		fmt.Fprintln(w, "  severity: fail")
+		fmt.Fprintf(w, "  duration_ms: %.3f\n", float64(e.Duration.Nanoseconds())/1e6)
+		tapFailure(w, "  ", e.Failures[0])
+		if len(e.Failures) > 1 {
+			fmt.Fprintln(w, "  failures:")
+			for _, f := range e.Failures {
+				fmt.Fprintln(w, "    -")
+				tapFailure(w, "      ", f)
+			}
+		}
+		fmt.Fprintln(w, "  ...")
+	}
+
+	if tr.out != nil {
+		tr.header()
+		_, _ = w.WriteTo(tr.out)
+	} else {
+		_, _ = w.WriteTo(&tr.points)
+	}
+}
+
+// FeatureStarted does nothing, since features are part of point names.
+func (tr *tapReporter) FeatureStarted(e Event) {}
+
+// GivenStarted does nothing, since contexts are part of point names.
+func (tr *tapReporter) GivenStarted(e Event) {}
+
+// WhenStarted does nothing, since situations are part of point names.
+func (tr *tapReporter) WhenStarted(e Event) {}
+
+// ItPassed writes an ok point.
+func (tr *tapReporter) ItPassed(e Event) {
+	tr.point(e, true, "")
+}
+
+// ItFailed writes a not ok point, with diagnostics.
+func (tr *tapReporter) ItFailed(e Event) {
+	tr.point(e, false, "")
+}
+
+// ItPending writes a not ok point, marked as TODO.
+func (tr *tapReporter) ItPending(e Event) {
+	tr.point(e, false, "TODO not implemented")
+}
+
+// ItSkipped writes an ok point, marked as SKIP.
+func (tr *tapReporter) ItSkipped(e Event) {
+	tr.point(e, true, "SKIP")
+}
+
+// GivenFinished does nothing, since points are written as they happen
+// or when closed.
+func (tr *tapReporter) GivenFinished(e Event) {}
+
+// Close writes the plan, when streaming, or the report file otherwise.
+func (tr *tapReporter) Close() (err error) {
+	if tr.out != nil {
+		tr.header()
+		_, err = fmt.Fprintf(tr.out, "1..%d\n", tr.count)
+	} else {
+		err = tr.write()
+	}
+	return
+}
+
+// write saves the whole report on file, with plan at the top.
+func (tr *tapReporter) write() (err error) {
+	content := fmt.Sprintf("TAP version 13\n1..%d\n%s", tr.count, tr.points.String())
+	if err = os.MkdirAll(filepath.Dir(tr.filename), 0755); err == nil {
+		err = ioutil.WriteFile(tr.filename, []byte(content), 0644)
+	}
+
+	err = errors.Wrap(err, "failed to write TAP report")
+	return
+}
@@ -0,0 +1,177 @@
+package spec
+
+import (
+	"os"
+	"strings"
+
+	"github.com/ddsgok/bdd/colors"
+	"github.com/pkg/errors"
+)
+
+// Theme holds the colors and marks used to print specifications.
+// Colors are ansi codes, joined from colors package constants, where
+// Keyword, String, Number and Comment highlight excerpts of code. Marks
+// are printed before each verification, by its status. Locations are
+// linked to their files only on terminals, without colors forced by
+// FORCE_COLOR, unless Hyperlinks turns them on wherever colors are.
+type Theme struct {
+	Feature            string
+	Given              string
+	When               string
+	Then               string
+	ThenNotImplemented string
+	ThenWithError      string
+	Code               string
+	CodeError          string
+	ExpectedError      string
+	Keyword            string
+	String             string
+	Number             string
+	Comment            string
+
+	Mark               string
+	MarkWithError      string
+	MarkNotImplemented string
+
+	Hyperlinks bool
+}
+
+var (
+	// themes are the named themes available to SetTheme.
+	themes = map[string]Theme{
+		"default": {
+			Feature:            colors.White,
+			Given:              colors.Grey,
+			When:               colors.LightGreen,
+			Then:               colors.Green,
+			ThenNotImplemented: colors.LightYellow,
+			ThenWithError:      strings.Join([]string{colors.RegBg, colors.White, colors.Bold}, ""),
+			Code:               colors.Grey,
+			CodeError:          strings.Join([]string{colors.White, colors.Bold}, ""),
+			ExpectedError:      colors.Red,
+			Keyword:            colors.LightMagenta,
+			String:             colors.Yellow,
+			Number:             colors.Cyan,
+			Comment:            colors.DarkGrey,
+			Mark:               "»",
+			MarkWithError:      "»",
+			MarkNotImplemented: "»",
+		},
+		"high-contrast": {
+			Feature:            strings.Join([]string{colors.White, colors.Bold, colors.Underline}, ""),
+			Given:              colors.White,
+			When:               colors.LightCyan,
+			Then:               colors.LightGreen,
+			ThenNotImplemented: strings.Join([]string{colors.BlackBg, colors.LightYellow}, ""),
+			ThenWithError:      strings.Join([]string{colors.RegBg, colors.White, colors.Bold}, ""),
+			Code:               colors.White,
+			CodeError:          strings.Join([]string{colors.White, colors.Bold, colors.Inverse}, ""),
+			ExpectedError:      colors.LightRed,
+			Keyword:            colors.LightMagenta,
+			String:             colors.LightYellow,
+			Number:             colors.LightCyan,
+			Comment:            colors.Grey,
+			Mark:               "✓",
+			MarkWithError:      "✗",
+			MarkNotImplemented: "•",
+		},
+		"monochrome": {
+			Mark:               "✓",
+			MarkWithError:      "✗",
+			MarkNotImplemented: "•",
+		},
+		"light-background": {
+			Feature:            strings.Join([]string{colors.Black, colors.Bold}, ""),
+			Given:              colors.DarkGray,
+			When:               colors.Blue,
+			Then:               colors.Green,
+			ThenNotImplemented: colors.Yellow,
+			ThenWithError:      strings.Join([]string{colors.RegBg, colors.White, colors.Bold}, ""),
+			Code:               colors.DarkGray,
+			CodeError:          strings.Join([]string{colors.Black, colors.Bold}, ""),
+			ExpectedError:      colors.Red,
+			Keyword:            colors.Magenta,
+			String:             colors.Red,
+			Number:             colors.Blue,
+			Comment:            colors.Grey,
+			Mark:               "»",
+			MarkWithError:      "»",
+			MarkNotImplemented: "»",
+		},
+	}
+)
+
+// AddTheme makes t available to SetTheme, and -bdd.theme flag, as
+// name. A theme already using name is replaced.
+func AddTheme(name string, t Theme) {
+	themes[name] = t
+}
+
+// SetTheme applies the theme named name on current configuration.
+// Available themes are default, high-contrast, monochrome and
+// light-background. Colors are left empty when not
+// enabled on output, as told by colors.Enabled(), checked again each
+// time output changes.
+//
+//    spec.SetTheme("monochrome")
+//
+// It's also set with -bdd.theme=light-background flag or BDD_THEME
+// environment variable.
+func SetTheme(name string) (err error) {
+	t, ok := themes[name]
+	if !ok {
+		err = errors.Errorf("theme %q not found", name)
+		return
+	}
+
+	config.applyTheme(t)
+	return
+}
+
+// applyTheme copies colors and marks of t to configuration, leaving
+// colors empty, and hyperlinks off, when not enabled on output. The
+// theme is kept to be applied again when output changes.
+func (c *Configuration) applyTheme(t Theme) {
+	c.theme = &t
+	w := c.writer()
+	enabled := colors.Enabled(w)
+	hyperlinks := enabled && (t.Hyperlinks || colors.Terminal(w) && !forcedColors())
+	if !enabled {
+		t = Theme{Mark: t.Mark, MarkWithError: t.MarkWithError, MarkNotImplemented: t.MarkNotImplemented}
+	}
+
+	c.AnsiOfFeature = t.Feature
+	c.AnsiOfGiven = t.Given
+	c.AnsiOfWhen = t.When
+	c.AnsiOfThen = t.Then
+	c.AnsiOfThenNotImplemented = t.ThenNotImplemented
+	c.AnsiOfThenWithError = t.ThenWithError
+	c.AnsiOfCode = t.Code
+	c.AnsiOfCodeError = t.CodeError
+	c.AnsiOfExpectedError = t.ExpectedError
+	c.AnsiOfKeyword = t.Keyword
+	c.AnsiOfString = t.String
+	c.AnsiOfNumber = t.Number
+	c.AnsiOfComment = t.Comment
+	c.Hyperlinks = hyperlinks
+	c.MarkOfThen = t.Mark
+	c.MarkOfThenWithError = t.MarkWithError
+	c.MarkOfThenNotImplemented = t.MarkNotImplemented
+}
+
+// detectColors applies again the theme of configuration, if any, so
+// colors are enabled according to current output. Configurations set
+// by SetConfig, without a theme, are kept as they are.
+func (c *Configuration) detectColors() {
+	if c.theme != nil {
+		c.applyTheme(*c.theme)
+	}
+}
+
+// forcedColors tells if colors are forced by FORCE_COLOR environment
+// variable, as on CI logs.
+func forcedColors() (b bool) {
+	force := os.Getenv("FORCE_COLOR")
+	b = force != "" && force != "0"
+	return
+}
@@ -0,0 +1,248 @@
+package spec
+
+import (
+	"time"
+)
+
+// itNode is a verification on the specification tree.
+type itNode struct {
+	Event
+	Tags []string
+}
+
+// whenNode is a situation on the specification tree.
+type whenNode struct {
+	Name string
+	Line int
+	Its  []*itNode
+}
+
+// Status returns the worst status among verifications of situation.
+func (w *whenNode) Status() (s Status) {
+	s = worstStatus(w.Its)
+	return
+}
+
+// givenNode is a context on the specification tree.
+type givenNode struct {
+	Name     string
+	File     string
+	Line     int
+	Duration time.Duration
+	Whens    []*whenNode
+}
+
+// its returns all verifications of context.
+func (g *givenNode) its() (its []*itNode) {
+	for _, w := range g.Whens {
+		its = append(its, w.Its...)
+	}
+	return
+}
+
+// Status returns the worst status among verifications of context.
+func (g *givenNode) Status() (s Status) {
+	s = worstStatus(g.its())
+	return
+}
+
+// featureNode is a feature on the specification tree.
+type featureNode struct {
+	Name     string
+	Duration time.Duration
+	Givens   []*givenNode
+	Counts   map[string]int
+}
+
+// Status returns the worst status among verifications of feature.
+func (f *featureNode) Status() (s Status) {
+	var its []*itNode
+	for _, g := range f.Givens {
+		its = append(its, g.its()...)
+	}
+
+	s = worstStatus(its)
+	return
+}
+
+var (
+	// severity orders status from best to worst.
+	severity = map[Status]int{
+		StatusPassed:  0,
+		StatusSkipped: 1,
+		StatusPending: 2,
+		StatusFailed:  3,
+	}
+)
+
+// worstStatus returns the worst status among verifications, where
+// failed is worse than pending, pending worse than skipped, and
+// skipped worse than passed. No verifications at all means not
+// implemented yet.
+func worstStatus(its []*itNode) (s Status) {
+	if len(its) == 0 {
+		s = StatusPending
+	}
+
+	for _, it := range its {
+		if severity[it.Status] > severity[s] {
+			s = it.Status
+		}
+	}
+	return
+}
+
+// specTree is the tree of features, contexts, situations and
+// verifications built from events, with counts of each status.
+type specTree struct {
+	Features []*featureNode
+	Counts   map[string]int
+	Tags     []string
+	Duration time.Duration
+
+	features map[string]*featureNode
+	tags     map[string]bool
+}
+
+// newSpecTree creates an empty specification tree.
+func newSpecTree() (st *specTree) {
+	st = &specTree{
+		Counts:   make(map[string]int),
+		features: make(map[string]*featureNode),
+		tags:     make(map[string]bool),
+	}
+	return
+}
+
+// feature returns the feature of event, creating if needed.
+func (st *specTree) feature(e Event) (f *featureNode) {
+	var ok bool
+	if f, ok = st.features[e.Feature]; !ok {
+		f = &featureNode{Name: e.Feature, Counts: make(map[string]int)}
+		st.features[e.Feature] = f
+		st.Features = append(st.Features, f)
+	}
+	return
+}
+
+// given returns the current context of event feature, creating if
+// needed.
+func (st *specTree) given(e Event) (g *givenNode) {
+	f := st.feature(e)
+	if n := len(f.Givens); n > 0 && f.Givens[n-1].Name == e.Given {
+		g = f.Givens[n-1]
+	} else {
+		g = st.startGiven(e)
+	}
+	return
+}
+
+// startGiven creates a new context on event feature.
+func (st *specTree) startGiven(e Event) (g *givenNode) {
+	f := st.feature(e)
+	g = &givenNode{Name: e.Given, File: relativePath(e.File), Line: e.Line}
+	f.Givens = append(f.Givens, g)
+	return
+}
+
+// when returns the current situation of event context, creating if
+// needed.
+func (st *specTree) when(e Event) (w *whenNode) {
+	g := st.given(e)
+	if n := len(g.Whens); n > 0 && g.Whens[n-1].Name == e.When {
+		w = g.Whens[n-1]
+	} else {
+		w = st.startWhen(e)
+	}
+	return
+}
+
+// startWhen creates a new situation on event context.
+func (st *specTree) startWhen(e Event) (w *whenNode) {
+	g := st.given(e)
+	w = &whenNode{Name: e.When, Line: e.Line}
+	g.Whens = append(g.Whens, w)
+	return
+}
+
+// addIt includes the verification on its situation.
+func (st *specTree) addIt(e Event) {
+	w := st.when(e)
+	it := &itNode{Event: e, Tags: e.Tags()}
+	w.Its = append(w.Its, it)
+
+	st.Counts[e.Status.String()]++
+	st.feature(e).Counts[e.Status.String()]++
+	for _, t := range it.Tags {
+		if !st.tags[t] {
+			st.tags[t] = true
+			st.Tags = append(st.Tags, t)
+		}
+	}
+}
+
+// finishGiven stores duration of context on its feature and tree.
+func (st *specTree) finishGiven(e Event) {
+	st.given(e).Duration = e.Duration
+	st.feature(e).Duration += e.Duration
+	st.Duration += e.Duration
+}
+
+// treeReporter builds the specification tree from events, and calls
+// write with the whole tree when closed.
+type treeReporter struct {
+	tree  *specTree
+	write func(*specTree) error
+}
+
+// newTreeReporter creates a reporter building a tree, written by fn.
+func newTreeReporter(fn func(*specTree) error) (tr *treeReporter) {
+	tr = &treeReporter{tree: newSpecTree(), write: fn}
+	return
+}
+
+// FeatureStarted creates the feature.
+func (tr *treeReporter) FeatureStarted(e Event) {
+	tr.tree.feature(e)
+}
+
+// GivenStarted creates a new context.
+func (tr *treeReporter) GivenStarted(e Event) {
+	tr.tree.startGiven(e)
+}
+
+// WhenStarted creates a new situation.
+func (tr *treeReporter) WhenStarted(e Event) {
+	tr.tree.startWhen(e)
+}
+
+// ItPassed includes a passed verification.
+func (tr *treeReporter) ItPassed(e Event) {
+	tr.tree.addIt(e)
+}
+
+// ItFailed includes a failed verification.
+func (tr *treeReporter) ItFailed(e Event) {
+	tr.tree.addIt(e)
+}
+
+// ItPending includes a pending verification.
+func (tr *treeReporter) ItPending(e Event) {
+	tr.tree.addIt(e)
+}
+
+// ItSkipped includes a skipped verification.
+func (tr *treeReporter) ItSkipped(e Event) {
+	tr.tree.addIt(e)
+}
+
+// GivenFinished stores context duration.
+func (tr *treeReporter) GivenFinished(e Event) {
+	tr.tree.finishGiven(e)
+}
+
+// Close writes the tree.
+func (tr *treeReporter) Close() (err error) {
+	err = tr.write(tr.tree)
+	return
+}
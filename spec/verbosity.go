@@ -0,0 +1,39 @@
+package spec
+
+const (
+	// VerbosityQuiet prints nothing, unless a verification fails, then
+	// printing only its Feature, Given, When and It path.
+	VerbosityQuiet Verbosity = iota - 2
+	// VerbosityProgress prints a character for each verification, and
+	// the failed ones at the end of each context.
+	VerbosityProgress
+	// VerbosityNormal prints the whole specification tree.
+	VerbosityNormal
+	// VerbosityDebug prints the whole specification tree, along with
+	// durations and arguments of each verification.
+	VerbosityDebug
+)
+
+var (
+	// verbosities maps names accepted by -bdd.verbosity to levels.
+	verbosities = map[string]Verbosity{
+		"quiet":    VerbosityQuiet,
+		"progress": VerbosityProgress,
+		"normal":   VerbosityNormal,
+		"debug":    VerbosityDebug,
+	}
+)
+
+// Verbosity defines how much of the specification tree is printed.
+type Verbosity int
+
+// SetVerbosity sets how much of the specification tree is printed,
+// using one of Verbosity constants.
+//
+//    spec.SetVerbosity(spec.VerbosityProgress)
+//
+// It's also set with -bdd.verbosity flag or BDD_VERBOSITY environment
+// variable, as quiet, progress, normal or debug.
+func SetVerbosity(v Verbosity) {
+	config.Verbosity = v
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ddsgok/bdd"
+)
+
+// asyncSpec runs a context where a counter never reaches the value
+// expected.
+func asyncSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a counter stuck at 1", func(when bdd.When) {
+		when("waited for", func(it bdd.It) {
+			it("should reach 2", func(assert bdd.Assert) {
+				assert.EventuallyWith(func(assert bdd.Assert) {
+					assert.Equal(2, 1)
+				}, 20*time.Millisecond, 5*time.Millisecond)
+			})
+		})
+	})
+}
+
+func Test_Async_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a counter incremented on a goroutine", func(when bdd.When) {
+		var counter int32
+		go func() {
+			for i := 0; i < 3; i++ {
+				time.Sleep(5 * time.Millisecond)
+				atomic.AddInt32(&counter, 1)
+			}
+		}()
+
+		when("asserted inside an It body", func(it bdd.It) {
+			it("should eventually reach 3", func(assert bdd.Assert) {
+				assert.Eventually(func() bool {
+					return atomic.LoadInt32(&counter) == 3
+				}, time.Second, time.Millisecond)
+			})
+
+			it("should eventually pass nested assertions", func(assert bdd.Assert) {
+				assert.EventuallyWith(func(assert bdd.Assert) {
+					assert.Equal(int32(3), atomic.LoadInt32(&counter))
+				}, time.Second, time.Millisecond)
+			})
+
+			it("should consistently stay at 3 and never go beyond", func(assert bdd.Assert) {
+				assert.Consistently(func() bool {
+					return atomic.LoadInt32(&counter) == 3
+				}, 20*time.Millisecond, 5*time.Millisecond)
+				assert.Never(func() bool {
+					return atomic.LoadInt32(&counter) > 3
+				}, 20*time.Millisecond, 5*time.Millisecond)
+			})
+		})
+
+		when("nested assertions never pass", func(it bdd.It) {
+			r := &recorder{}
+			record(asyncSpec, r)
+
+			it("should report the timeout and the last failure", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 2) {
+					assert.Contains(r.events[3].Failures[0].Message, "Assertions never succeeded within 20ms")
+					assert.Contains(r.events[3].Failures[1].Message, "Not equal")
+					assert.Contains(r.events[3].Failures[1].File, "async_test.go")
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// failureOf runs fn as the body of a verification, returning its
+// first failure.
+func failureOf(fn func(bdd.Assert)) (f spec.Failure) {
+	r := &recorder{}
+	record(func() {
+		given := bdd.Sentences().Given()
+
+		given(&testing.T{}, "a helper", func(when bdd.When) {
+			when("it fails", func(it bdd.It) {
+				it("should be located", fn)
+			})
+		})
+	}, r)
+
+	for _, e := range r.events {
+		if len(e.Failures) > 0 {
+			f = e.Failures[0]
+		}
+	}
+	return
+}
+
+// failingText returns the text of failing line on excerpt.
+func failingText(f spec.Failure) (text string) {
+	for _, l := range f.Excerpt {
+		if l.Number == f.Line {
+			text = strings.TrimSpace(l.Text)
+		}
+	}
+	return
+}
+
+func Test_Caller_Detection(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "assertions made on a shared helper file", func(when bdd.When) {
+		when("the helper is not marked", func(it bdd.It) {
+			f := failureOf(func(assert bdd.Assert) {
+				assertPositive(assert, -1)
+			})
+
+			it("should locate the failure inside helper", func(assert bdd.Assert) {
+				assert.Contains(f.File, "helpers.go")
+				assert.Equal("assert.True(n > 0)", failingText(f))
+			})
+
+			it("should keep the chain of user calls", func(assert bdd.Assert) {
+				if assert.True(len(f.Stack) > 2) {
+					assert.Contains(f.Stack[1].File, "caller_test.go")
+					assert.Contains(f.Stack[1].Function, "Test_Caller_Detection")
+				}
+			})
+		})
+
+		when("the helper is marked with bdd.Helper()", func(it bdd.It) {
+			f := failureOf(func(assert bdd.Assert) {
+				assertPositiveHelper(assert, -1)
+			})
+
+			it("should locate the failure where helper was called", func(assert bdd.Assert) {
+				assert.Contains(f.File, "caller_test.go")
+				assert.Equal("assertPositiveHelper(assert, -1)", failingText(f))
+			})
+		})
+	})
+
+	given(t, "more context lines configured", func(when bdd.When) {
+		when("an assertion fails", func(it bdd.It) {
+			previous := *spec.Config()
+			spec.Config().ContextLines = 3
+			f := failureOf(func(assert bdd.Assert) {
+				assert.True(false)
+			})
+			spec.SetConfig(previous)
+
+			it("should show those lines around failing one", func(assert bdd.Assert) {
+				assert.Len(f.Excerpt, 7)
+			})
+		})
+	})
+
+	given(t, "negative context lines configured", func(when bdd.When) {
+		when("an assertion fails", func(it bdd.It) {
+			previous := *spec.Config()
+			buffer := &bytes.Buffer{}
+			printTo(func() {
+				spec.Config().ContextLines = -1
+				sampleSpec()
+			}, func() {
+				spec.SetWriter(buffer)
+			})
+			spec.SetConfig(previous)
+
+			it("should still print the location", func(assert bdd.Assert) {
+				assert.That(buffer.String(), bdd.MatchesRegexp(`in test/reporter_test.go:\d+:\d+`))
+			})
+
+			it("should not print the excerpt", func(assert bdd.Assert) {
+				assert.NotContains(buffer.String(), "---------")
+				assert.NotContains(buffer.String(), "assert.Equal(1, 2)")
+			})
+		})
+	})
+}
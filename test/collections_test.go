@@ -0,0 +1,72 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+)
+
+// collectionsSpec runs a context failing to find a repeated element.
+func collectionsSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a list with a repeated name", func(when bdd.When) {
+		when("checked for repetitions", func(it bdd.It) {
+			it("should have unique names", func(assert bdd.Assert) {
+				assert.Unique([]string{"Rex", "Max", "Rex"})
+			})
+		})
+	})
+}
+
+func Test_Collection_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a list of pets and a map of their ages", func(when bdd.When) {
+		pets := []pet{{Name: "Max"}, {Name: "Rex"}, {Name: "Toby"}}
+		ages := map[string]int{"Max": 3, "Rex": 5, "Toby": 8}
+
+		when("asserted inside an It body", func(it bdd.It) {
+			it("should find their elements", func(assert bdd.Assert) {
+				assert.Contains(pets, pet{Name: "Rex"})
+				assert.NotContains(pets, pet{Name: "Bob"})
+				assert.Contains(ages, 5)
+				assert.HasKey(ages, "Toby")
+			})
+
+			it("should compare them as sets", func(assert bdd.Assert) {
+				assert.ElementsMatch([]string{"Toby", "Max", "Rex"}, []string{"Max", "Rex", "Toby"})
+				assert.Subset(pets, []pet{{Name: "Toby"}, {Name: "Max"}})
+				assert.NotSubset(pets, []pet{{Name: "Bob"}})
+				assert.Subset(ages, map[string]int{"Rex": 5})
+			})
+
+			it("should check their order and elements", func(assert bdd.Assert) {
+				assert.Unique(pets)
+				assert.IsSorted([]int{3, 5, 8})
+				assert.IsSortedFunc(pets, func(a, b interface{}) bool {
+					return a.(pet).Name < b.(pet).Name
+				})
+				assert.Each(ages, func(age interface{}) bool {
+					return age.(int) > 0
+				})
+			})
+
+			it("should still find substrings", func(assert bdd.Assert) {
+				assert.Contains(strings.ToUpper("rex"), "EX")
+			})
+		})
+
+		when("an element is repeated", func(it bdd.It) {
+			r := &recorder{}
+			record(collectionsSpec, r)
+
+			it("should fail pointing at its index", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					assert.Contains(r.events[3].Failures[0].Message, `"Rex" is repeated at [2], first seen at [0]`)
+				}
+			})
+		})
+	})
+}
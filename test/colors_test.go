@@ -0,0 +1,84 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/colors"
+)
+
+func Test_Colors_Style(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "an orange bold style", func(when bdd.When) {
+		orange := colors.Style().Fg(colors.RGB(255, 128, 0)).Bold()
+
+		when("printed with true colors", func(it bdd.It) {
+			s := orange.Level(colors.LevelTrueColor).Sprint("text")
+
+			it("should use the 24-bit color", func(assert bdd.Assert) {
+				assert.Equal("\033[1;38;2;255;128;0mtext\033[0m", s)
+			})
+		})
+
+		when("printed with 256 colors", func(it bdd.It) {
+			s := orange.Level(colors.Level256).Sprint("text")
+
+			it("should use the nearest color of palette", func(assert bdd.Assert) {
+				assert.Equal("\033[1;38;5;214mtext\033[0m", s)
+			})
+		})
+
+		when("printed with 16 colors", func(it bdd.It) {
+			s := orange.Level(colors.LevelBasic).Sprint("text")
+
+			it("should use the nearest basic color", func(assert bdd.Assert) {
+				assert.Equal("\033[1;33mtext\033[0m", s)
+			})
+		})
+
+		when("printed without colors", func(it bdd.It) {
+			s := orange.Level(colors.LevelNone).Sprint("text")
+
+			it("should print text as is", func(assert bdd.Assert) {
+				assert.Equal("text", s)
+			})
+		})
+	})
+
+	given(t, "a bright basic background", func(when bdd.When) {
+		s := colors.Style().Bg(colors.Basic(12)).Level(colors.LevelTrueColor).Sprint("text")
+
+		when("printed with true colors", func(it bdd.It) {
+			it("should keep the basic color", func(assert bdd.Assert) {
+				assert.Equal("\033[104mtext\033[0m", s)
+			})
+		})
+	})
+}
+
+func Test_Colors_Width(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a colored text", func(when bdd.When) {
+		text := colors.Green + "ok" + colors.Reset
+
+		when("measured", func(it bdd.It) {
+			it("should ignore escape codes", func(assert bdd.Assert) {
+				assert.Equal(2, colors.Width(text))
+				assert.Equal("ok", colors.Strip(text))
+			})
+
+			it("should count wide runes as two columns", func(assert bdd.Assert) {
+				assert.Equal(4, colors.Width("日本"))
+			})
+		})
+
+		when("padded", func(it bdd.It) {
+			it("should fill visible columns", func(assert bdd.Assert) {
+				assert.Equal(text+"   ", colors.PadRight(text, 5))
+				assert.Equal("   "+text, colors.PadLeft(text, 5))
+			})
+		})
+	})
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ddsgok/bdd"
+)
+
+// compareSpec runs a context failing to compare two numbers.
+func compareSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a pet of 3 years", func(when bdd.When) {
+		when("compared to an adult age", func(it bdd.It) {
+			it("should be older", func(assert bdd.Assert) {
+				assert.Greater(3, 5)
+			})
+		})
+	})
+}
+
+func Test_Comparison_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "numbers, strings and times", func(when bdd.When) {
+		when("asserted inside an It body", func(it bdd.It) {
+			it("should compare them", func(assert bdd.Assert) {
+				assert.Greater(uint8(5), 3)
+				assert.GreaterOrEqual(2.5, 2.5)
+				assert.Less("Max", "Rex")
+				assert.LessOrEqual(time.Now().Add(-time.Hour), time.Now())
+				assert.Between(time.Minute, time.Second, time.Hour)
+			})
+
+			it("should check their sign", func(assert bdd.Assert) {
+				assert.Positive(int64(1))
+				assert.Negative(-0.5)
+				assert.Zero(0)
+				assert.Zero("")
+			})
+
+			it("should check special floats", func(assert bdd.Assert) {
+				assert.IsNaN(math.NaN())
+				assert.IsInf(math.Inf(-1), -1)
+			})
+		})
+
+		when("the relation does not hold", func(it bdd.It) {
+			r := &recorder{}
+			record(compareSpec, r)
+
+			it("should fail printing both operands and the relation", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					assert.Contains(r.events[3].Failures[0].Message, "3 should be greater than 5")
+				}
+			})
+		})
+	})
+}
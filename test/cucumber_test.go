@@ -0,0 +1,82 @@
+package test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+func Test_Cucumber_Reporter(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a Cucumber reporter writing to a file", func(when bdd.When) {
+		file := filepath.Join(t.TempDir(), "cucumber.json")
+
+		when("a spec with passing, failing and pending verifications runs", func(it bdd.It) {
+			_ = recordClosed(sampleSpec, spec.NewCucumberReporter(file))
+
+			var report []struct {
+				Name     string `json:"name"`
+				URI      string `json:"uri"`
+				Elements []struct {
+					Name  string `json:"name"`
+					Line  int    `json:"line"`
+					Steps []struct {
+						Keyword string `json:"keyword"`
+						Name    string `json:"name"`
+						Line    int    `json:"line"`
+						Result  struct {
+							Status       string `json:"status"`
+							ErrorMessage string `json:"error_message"`
+						} `json:"result"`
+					} `json:"steps"`
+				} `json:"elements"`
+			}
+
+			bytes, err := ioutil.ReadFile(file)
+			if err == nil {
+				err = json.Unmarshal(bytes, &report)
+			}
+
+			it("should write a valid JSON file", func(assert bdd.Assert) {
+				assert.NoError(err)
+			})
+
+			it("should have a feature with a scenario per verification", func(assert bdd.Assert) {
+				if assert.Len(report, 1) {
+					assert.Equal("sampleSpec", report[0].Name)
+					assert.Equal("reporter_test.go", report[0].URI)
+					assert.Len(report[0].Elements, 3)
+				}
+			})
+
+			it("should have Given, When and Then steps with lines", func(assert bdd.Assert) {
+				if assert.Len(report, 1) && assert.Len(report[0].Elements, 3) {
+					steps := report[0].Elements[0].Steps
+					if assert.Len(steps, 3) {
+						assert.Equal("Given ", steps[0].Keyword)
+						assert.Equal("a context", steps[0].Name)
+						assert.Equal("When ", steps[1].Keyword)
+						assert.Equal("Then ", steps[2].Keyword)
+						assert.Equal("should pass", steps[2].Name)
+						assert.True(steps[0].Line > 0 && steps[0].Line < steps[1].Line && steps[1].Line < steps[2].Line)
+					}
+				}
+			})
+
+			it("should report status of each verification", func(assert bdd.Assert) {
+				if assert.Len(report, 1) && assert.Len(report[0].Elements, 3) {
+					elements := report[0].Elements
+					assert.Equal("passed", elements[0].Steps[2].Result.Status)
+					assert.Equal("failed", elements[1].Steps[2].Result.Status)
+					assert.Contains(elements[1].Steps[2].Result.ErrorMessage, "Not equal")
+					assert.Equal("pending", elements[2].Steps[2].Result.Status)
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/ddsgok/bdd"
+)
+
+// pet is a value compared on diff specifications.
+type pet struct {
+	Name string
+	Tags []string
+}
+
+// diffSpec runs a context failing to compare structs.
+func diffSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "two different pets", func(when bdd.When) {
+		when("compared", func(it bdd.It) {
+			it("should be equal", func(assert bdd.Assert) {
+				assert.Equal(pet{Name: "Rex", Tags: []string{"a"}}, pet{Name: "Max", Tags: []string{"a"}})
+			})
+		})
+	})
+}
+
+func Test_Diff_On_Failures(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a failed comparison of structs", func(when bdd.When) {
+		r := &recorder{}
+
+		when("reported", func(it bdd.It) {
+			record(diffSpec, r)
+
+			it("should describe only the differences", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					m := r.events[3].Failures[0].Message
+					assert.Contains(m, "Diff (-expected +actual):")
+					assert.Contains(m, "\t .Name:\n\t-  \"Rex\"\n\t+  \"Max\"")
+					assert.NotContains(m, "Tags")
+				}
+			})
+		})
+	})
+}
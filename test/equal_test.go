@@ -0,0 +1,72 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ddsgok/bdd"
+)
+
+// visit is a value compared with options.
+type visit struct {
+	Pet       pet
+	Weight    float64
+	At        time.Time
+	CreatedAt time.Time
+}
+
+// equalWithSpec runs a context failing to compare visits, even with
+// some fields ignored.
+func equalWithSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "two visits of different pets", func(when bdd.When) {
+		when("compared ignoring when they were created", func(it bdd.It) {
+			it("should be equal", func(assert bdd.Assert) {
+				assert.EqualWith(
+					visit{Pet: pet{Name: "Rex"}, CreatedAt: time.Now()},
+					visit{Pet: pet{Name: "Max"}},
+					bdd.IgnoreFields("CreatedAt"),
+				)
+			})
+		})
+	})
+}
+
+func Test_Equal_With_Options(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a visit recorded twice", func(when bdd.When) {
+		at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		expected := visit{Pet: pet{Name: "Rex", Tags: []string{"dog", "good"}}, Weight: 30, At: at}
+		actual := visit{Pet: pet{Name: "Rex", Tags: []string{"good", "dog"}}, Weight: 30.001, At: at.In(time.Local), CreatedAt: time.Now()}
+
+		when("compared with options", func(it bdd.It) {
+			it("should be equal", func(assert bdd.Assert) {
+				assert.EqualWith(expected, actual,
+					bdd.IgnoreFields("CreatedAt"),
+					bdd.SortSlices(func(a, b string) bool { return a < b }),
+					bdd.FloatTolerance(0.01),
+					bdd.Comparer(func(a, b time.Time) bool { return a.Equal(b) }),
+				)
+			})
+
+			it("should be equal ignoring empty and unexported values", func(assert bdd.Assert) {
+				assert.EqualWith(pet{Name: "Rex"}, pet{Name: "Rex", Tags: []string{}}, bdd.EquateEmpty(), bdd.IgnoreUnexported())
+			})
+		})
+
+		when("compared with options, but still different", func(it bdd.It) {
+			r := &recorder{}
+			record(equalWithSpec, r)
+
+			it("should diff only fields not ignored", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					m := r.events[3].Failures[0].Message
+					assert.Contains(m, "\t .Pet.Name:\n\t-  \"Rex\"\n\t+  \"Max\"")
+					assert.NotContains(m, "CreatedAt")
+				}
+			})
+		})
+	})
+}
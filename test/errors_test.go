@@ -0,0 +1,65 @@
+package test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/pkg/errors"
+)
+
+// errorsSpec runs a context failing to find an error on a chain.
+func errorsSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a wrapped error", func(when bdd.When) {
+		when("checked for another error", func(it bdd.It) {
+			it("should match it", func(assert bdd.Assert) {
+				err := errors.Wrap(fmt.Errorf("reading: %w", os.ErrNotExist), "loading")
+				assert.ErrorIs(err, os.ErrPermission)
+			})
+		})
+	})
+}
+
+func Test_Error_Chain_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "an error wrapped by fmt and pkg/errors", func(when bdd.When) {
+		err := errors.Wrap(fmt.Errorf("reading: %w", &os.PathError{Op: "open", Path: "config.yml", Err: os.ErrNotExist}), "loading")
+
+		when("asserted inside an It body", func(it bdd.It) {
+			it("should find errors on its chain", func(assert bdd.Assert) {
+				assert.ErrorIs(err, os.ErrNotExist)
+				assert.NotErrorIs(err, os.ErrPermission)
+			})
+
+			it("should populate the target of its type", func(assert bdd.Assert) {
+				var pathErr *os.PathError
+				if assert.ErrorAs(err, &pathErr) {
+					assert.Equal("config.yml", pathErr.Path)
+				}
+			})
+
+			it("should check its message", func(assert bdd.Assert) {
+				assert.ErrorContains(err, "config.yml")
+				assert.ErrorMatches(err, `^loading: reading: open \S+: file does not exist$`)
+			})
+		})
+
+		when("the error is not on its chain", func(it bdd.It) {
+			r := &recorder{}
+			record(errorsSpec, r)
+
+			it("should fail printing the whole chain", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					m := r.events[3].Failures[0].Message
+					assert.Contains(m, "Error chain:")
+					assert.Contains(m, "1. *errors.withStack: loading: reading: file does not exist")
+					assert.Contains(m, "4. *errors.errorString: file does not exist")
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+)
+
+// genericLine is the line before the typed assertion failing on
+// genericSpec.
+var genericLine int
+
+// genericSpec runs a context where a typed assertion fails.
+func genericSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a pet weighing 30", func(when bdd.When) {
+		var weight int64 = 30
+
+		when("weighed", func(it bdd.It) {
+			it("should weigh 12", func(assert bdd.Assert) {
+				_, _, genericLine, _ = runtime.Caller(0)
+				bdd.Equal(assert, 12, weight)
+			})
+		})
+	})
+}
+
+func Test_Generic_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a pet named Rex, tagged as dog", func(when bdd.When) {
+		p := &pet{Name: "Rex", Tags: []string{"dog", "good"}}
+		var weight int64 = 30
+		owners := map[string]int{"Tom": 1}
+
+		when("asserted with typed functions", func(it bdd.It) {
+			it("should pass", func(assert bdd.Assert) {
+				bdd.Equal(assert, "Rex", p.Name)
+				bdd.Equal(assert, 30, weight)
+				bdd.NotEqual(assert, 0, weight)
+				bdd.Contains(assert, p.Tags, "dog")
+				bdd.NotContains(assert, p.Tags, "cat")
+				bdd.ElementsMatch(assert, []string{"good", "dog"}, p.Tags)
+				bdd.MapHasKey(assert, owners, "Tom")
+				bdd.Greater(assert, weight, 10)
+				bdd.Less(assert, p.Name, "Tom")
+			})
+		})
+
+		when("a typed assertion fails", func(it bdd.It) {
+			r := &recorder{}
+			record(genericSpec, r)
+
+			it("should be reported on the line calling it", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					assert.Contains(r.events[3].Failures[0].Message, "Not equal: 12 (expected)")
+					assert.Contains(r.events[3].Failures[0].File, "generic_test.go")
+					assert.Equal(genericLine+1, r.events[3].Failures[0].Line)
+				}
+			})
+		})
+	})
+}
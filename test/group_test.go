@@ -0,0 +1,107 @@
+package test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// groupLine is the line before the group failing on groupSpec.
+var groupLine int
+
+// groupSpec runs a context where a pet has more than one field wrong,
+// checked on a group stopped by Require().
+func groupSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a pet named Tom, without tags", func(when bdd.When) {
+		p := &pet{Name: "Tom"}
+
+		when("its fields are checked", func(it bdd.It) {
+			it("should be Rex, tagged as a dog", func(assert bdd.Assert) {
+				_, _, groupLine, _ = runtime.Caller(0)
+				assert.All(func(assert bdd.Assert) {
+					assert.Equal("Rex", p.Name)
+					assert.Require().NotEmpty(p.Tags)
+					assert.Equal("dog", p.Tags[0])
+				}, "pet fields")
+				assert.Equal("Rex", p.Name)
+			})
+		})
+	})
+}
+
+func Test_Group_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a group of assertions failing", func(when bdd.When) {
+		r := &recorder{}
+
+		when("the verification runs", func(it bdd.It) {
+			record(groupSpec, r)
+
+			it("should report the group as one failure", func(assert bdd.Assert) {
+				assert.Equal([]string{"feature", "given", "when", "failed", "finished"}, r.kinds)
+				if assert.Len(r.events[3].Failures, 2) {
+					assert.Contains(r.events[3].Failures[0].Message, "2 assertions failed on pet fields:")
+					assert.Contains(r.events[3].Failures[0].File, "group_test.go")
+					assert.Equal(groupLine+1, r.events[3].Failures[0].Line)
+				}
+			})
+
+			it("should number every failure with its line", func(assert bdd.Assert) {
+				message := r.events[3].Failures[0].Message
+				assert.Contains(message, fmt.Sprintf("1. test/group_test.go:%d", groupLine+2))
+				assert.Contains(message, fmt.Sprintf("2. test/group_test.go:%d", groupLine+3))
+				assert.NotContains(message, "3.")
+			})
+
+			it("should go on after the group, stopped by Require()", func(assert bdd.Assert) {
+				assert.Contains(r.events[3].Failures[1].Message, "Not equal")
+			})
+		})
+
+		when("every assertion succeeds", func(it bdd.It) {
+			it("should pass", func(assert bdd.Assert) {
+				p := &pet{Name: "Rex"}
+				assert.True(assert.All(func(assert bdd.Assert) {
+					assert.Equal("Rex", p.Name)
+					assert.Empty(p.Tags)
+				}))
+			})
+		})
+	})
+
+	given(t, "a group failing after another assertion", func(when bdd.When) {
+		when("the verification runs on a specification", func(it bdd.It) {
+			var sp *spec.TestSpecification
+			record(func() {
+				sp = spec.New(&testing.T{}, "Group Assertions", "a strict group")
+				sp.It = "should keep its messages"
+				sp.AssertFn = func(assert bdd.Assert) {
+					assert.Equal(1, 2)
+					assert.All(func(assert bdd.Assert) {
+						assert.True(false)
+						assert.True(false)
+					})
+				}
+				sp.Run()
+			}, &recorder{})
+
+			it("should keep a message for each failure after running", func(assert bdd.Assert) {
+				if assert.Len(sp.AssertionFailedMessages, 2) {
+					assert.Contains(sp.AssertionFailedMessages[0], "Not equal")
+					assert.Contains(sp.AssertionFailedMessages[1], "2 assertions failed")
+				}
+			})
+
+			it("should forget them when the context finishes", func(assert bdd.Assert) {
+				record(sp.Finish, &recorder{})
+				assert.Empty(sp.AssertionFailedMessages)
+			})
+		})
+	})
+}
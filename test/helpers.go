@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/ddsgok/bdd"
+)
+
+// assertPositive asserts n is positive, from a shared helper file.
+func assertPositive(assert bdd.Assert, n int) {
+	assert.True(n > 0)
+}
+
+// assertPositiveHelper asserts n is positive, marked as helper.
+func assertPositiveHelper(assert bdd.Assert, n int) {
+	bdd.Helper()
+	assert.True(n > 0)
+}
@@ -0,0 +1,70 @@
+package test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// taggedSpec runs a context with tagged sentences.
+func taggedSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "@slow a big file", func(when bdd.When) {
+		when("it is read", func(it bdd.It) {
+			it("should work @smoke", func(assert bdd.Assert) {
+				assert.True(true)
+			})
+		})
+	})
+}
+
+func Test_HTML_Reporter(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a HTML reporter writing to a file", func(when bdd.When) {
+		file := filepath.Join(t.TempDir(), "specs.html")
+
+		when("specs with passing, failing, pending and tagged verifications run", func(it bdd.It) {
+			_ = recordClosed(func() {
+				sampleSpec()
+				taggedSpec()
+			}, spec.NewHTMLReporter(file))
+
+			bytes, err := ioutil.ReadFile(file)
+			html := string(bytes)
+
+			it("should write the file", func(assert bdd.Assert) {
+				assert.NoError(err)
+			})
+
+			it("should have the tree of sentences", func(assert bdd.Assert) {
+				assert.Contains(html, "Feature: sampleSpec")
+				assert.Contains(html, "Given a context")
+				assert.Contains(html, "When an event")
+				assert.Equal(4, strings.Count(html, `<div class="it `))
+			})
+
+			it("should mark status of each verification", func(assert bdd.Assert) {
+				assert.Contains(html, `data-status="passed"`)
+				assert.Contains(html, `data-status="failed"`)
+				assert.Contains(html, `data-status="pending"`)
+			})
+
+			it("should include failure message and excerpt of code", func(assert bdd.Assert) {
+				assert.Contains(html, "Not equal")
+				assert.Contains(html, "assert.Equal(1, 2)")
+			})
+
+			it("should list tags found on sentences for filtering", func(assert bdd.Assert) {
+				assert.Contains(html, "<option>@slow</option>")
+				assert.Contains(html, "<option>@smoke</option>")
+				assert.Contains(html, `data-tags="@slow @smoke"`)
+			})
+		})
+	})
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+func Test_JUnit_Reporter(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a JUnit reporter writing to a file", func(when bdd.When) {
+		file := filepath.Join(t.TempDir(), "reports", "junit.xml")
+
+		when("a spec with passing, failing and pending verifications runs", func(it bdd.It) {
+			_ = recordClosed(sampleSpec, spec.NewJUnitReporter(file))
+
+			var report struct {
+				Suites []struct {
+					Name     string `xml:"name,attr"`
+					Tests    int    `xml:"tests,attr"`
+					Failures int    `xml:"failures,attr"`
+					Skipped  int    `xml:"skipped,attr"`
+					Cases    []struct {
+						Name    string `xml:"name,attr"`
+						Failure *struct {
+							Message string `xml:"message,attr"`
+							Content string `xml:",chardata"`
+						} `xml:"failure"`
+						Skipped *struct{} `xml:"skipped"`
+					} `xml:"testcase"`
+				} `xml:"testsuite"`
+			}
+
+			bytes, err := ioutil.ReadFile(file)
+			if err == nil {
+				err = xml.Unmarshal(bytes, &report)
+			}
+
+			it("should write a valid XML file", func(assert bdd.Assert) {
+				assert.NoError(err)
+			})
+
+			it("should have a testsuite per feature", func(assert bdd.Assert) {
+				if assert.Len(report.Suites, 1) {
+					s := report.Suites[0]
+					assert.Equal("sampleSpec", s.Name)
+					assert.Equal(3, s.Tests)
+					assert.Equal(1, s.Failures)
+					assert.Equal(1, s.Skipped)
+				}
+			})
+
+			it("should have a testcase per verification", func(assert bdd.Assert) {
+				if assert.Len(report.Suites, 1) && assert.Len(report.Suites[0].Cases, 3) {
+					cases := report.Suites[0].Cases
+					assert.Equal("Given a context When an event It should pass", cases[0].Name)
+					assert.Nil(cases[0].Failure)
+					assert.NotNil(cases[1].Failure)
+					assert.NotNil(cases[2].Skipped)
+				}
+			})
+
+			it("should include failure message and excerpt of code", func(assert bdd.Assert) {
+				if assert.Len(report.Suites, 1) && assert.Len(report.Suites[0].Cases, 3) {
+					f := report.Suites[0].Cases[1].Failure
+					assert.Contains(f.Message, "Not equal")
+					assert.Contains(f.Content, "assert.Equal(1, 2)")
+				}
+			})
+		})
+	})
+}
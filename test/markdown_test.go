@@ -0,0 +1,72 @@
+package test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// likeSpec runs a context with a verification using like sentence,
+// where the last row fails.
+func likeSpec() {
+	given, like, s := bdd.Sentences().All()
+
+	given(&testing.T{}, "a number", func(when bdd.When) {
+		when("doubled", func(it bdd.It) {
+			it("should turn %[1]v into %[2]v", func(assert bdd.Assert, args ...interface{}) {
+				assert.Equal(args[1], 2*args[0].(int))
+			}, like(s(1, 2), s(2, 4), s(3, 7)))
+		})
+	})
+}
+
+func Test_Markdown_Reporter(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a Markdown reporter writing to a file", func(when bdd.When) {
+		file := filepath.Join(t.TempDir(), "SPECS.md")
+
+		when("specs with simple and like verifications run", func(it bdd.It) {
+			_ = recordClosed(func() {
+				sampleSpec()
+				likeSpec()
+			}, spec.NewMarkdownReporter(file))
+
+			bytes, err := ioutil.ReadFile(file)
+			md := string(bytes)
+
+			it("should write the file", func(assert bdd.Assert) {
+				assert.NoError(err)
+			})
+
+			it("should start with a summary table", func(assert bdd.Assert) {
+				assert.True(strings.HasPrefix(md, "# Specifications\n\n| Feature |"))
+				assert.Contains(md, "| ❌ sampleSpec | 1 | 1 | 1 |")
+				assert.Contains(md, "| **Total** | 3 | 2 | 1 |")
+			})
+
+			it("should have a heading per feature", func(assert bdd.Assert) {
+				assert.Contains(md, "\n## Feature: sampleSpec\n")
+				assert.Contains(md, "\n## Feature: likeSpec\n")
+			})
+
+			it("should have nested lists with status markers", func(assert bdd.Assert) {
+				assert.Contains(md, "- ❌ **Given** a context\n  - ❌ **When** an event\n    - ✅ It should pass\n    - ❌ It should fail\n")
+				assert.Contains(md, "    - ⏳ It should be pending\n")
+			})
+
+			it("should render like rows as a table", func(assert bdd.Assert) {
+				assert.Contains(md, "      | ✅ | should turn 1 into 2 | 1 | 2 |\n")
+				assert.Contains(md, "      | ❌ | should turn 3 into 7 | 3 | 7 |\n")
+			})
+
+			it("should include failure details", func(assert bdd.Assert) {
+				assert.Contains(md, "assert.Equal(args[1], 2*args[0].(int))")
+			})
+		})
+	})
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+)
+
+// taggedAs is a domain matcher of pets having a tag.
+type taggedAs string
+
+func (tag taggedAs) Match(actual interface{}) bool {
+	p, ok := actual.(*pet)
+	if !ok {
+		return false
+	}
+
+	for _, t := range p.Tags {
+		if t == string(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func (tag taggedAs) Describe() string {
+	return fmt.Sprintf("a pet tagged as %q", string(tag))
+}
+
+func (tag taggedAs) DescribeMismatch(actual interface{}) string {
+	return fmt.Sprintf("was tagged as %v", actual.(*pet).Tags)
+}
+
+// matcherSpec runs a context where a pet doesn't match a domain
+// matcher.
+func matcherSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a pet tagged as cat", func(when bdd.When) {
+		p := &pet{Name: "Tom", Tags: []string{"cat"}}
+
+		when("matched", func(it bdd.It) {
+			it("should be a dog named Tom", func(assert bdd.Assert) {
+				assert.That(p, bdd.AllOf(bdd.HasField("Name", bdd.EqualTo("Tom")), taggedAs("dog")))
+			})
+		})
+	})
+}
+
+func Test_Matcher_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a pet named Rex, tagged as dog and good", func(when bdd.When) {
+		p := &pet{Name: "Rex", Tags: []string{"dog", "good"}}
+
+		when("matched with built-in matchers", func(it bdd.It) {
+			it("should match combinators", func(assert bdd.Assert) {
+				assert.That(p, bdd.AllOf(
+					bdd.HasField("Name", bdd.AnyOf(bdd.EqualTo("Rex"), bdd.EqualTo("Max"))),
+					bdd.HasField("Tags", bdd.HasLen(2)),
+					bdd.Not(bdd.HasField("Name", bdd.MatchesRegexp("^T"))),
+				))
+				assert.That(len(p.Name), bdd.Approximately(3.5, 0.5))
+			})
+
+			it("should match domain matchers", func(assert bdd.Assert) {
+				assert.That(p, taggedAs("good"))
+			})
+		})
+
+		when("a domain matcher fails", func(it bdd.It) {
+			r := &recorder{}
+			record(matcherSpec, r)
+
+			it("should report its descriptions", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					assert.Contains(r.events[3].Failures[0].Message, `Expected: (has field Name equal to "Tom" and a pet tagged as "dog")`)
+					assert.Contains(r.events[3].Failures[0].Message, "but: was tagged as [cat]")
+					assert.Contains(r.events[3].Failures[0].File, "matchers_test.go")
+				}
+			})
+		})
+	})
+}
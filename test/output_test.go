@@ -0,0 +1,103 @@
+package test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// printTo runs fn printing only through console reporter, with output
+// set by configure, restoring previous configuration after.
+func printTo(fn func(), configure func()) {
+	previous := *spec.Config()
+	defer func() {
+		spec.Config().Output, spec.Config().Writer = previous.Output, previous.Writer
+	}()
+
+	configure()
+	record(fn, spec.NewConsoleReporter())
+}
+
+func Test_Output_Destinations(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "output sent to a writer", func(when bdd.When) {
+		buffer := &bytes.Buffer{}
+
+		when("a spec runs", func(it bdd.It) {
+			printTo(sampleSpec, func() {
+				spec.SetWriter(buffer)
+			})
+
+			it("should print the tree on writer", func(assert bdd.Assert) {
+				assert.Contains(buffer.String(), "Feature: sampleSpec")
+				assert.Contains(buffer.String(), "Given a context")
+				assert.Contains(buffer.String(), "When an event")
+				assert.Contains(buffer.String(), "It should fail")
+			})
+		})
+	})
+
+	given(t, "output sent through t.Log", func(when bdd.When) {
+		buffer := &bytes.Buffer{}
+
+		when("a spec runs", func(it bdd.It) {
+			printTo(sampleSpec, func() {
+				spec.SetWriter(buffer)
+				spec.SetOutput(spec.OutputTestLog)
+			})
+
+			it("should print nothing on writer", func(assert bdd.Assert) {
+				assert.Empty(buffer.String())
+			})
+		})
+
+		when("specs run on a test of its own", func(it bdd.It) {
+			cmd := exec.Command(os.Args[0], "-test.run=^Test_Output_Through_Test_Log$", "-test.v")
+			cmd.Env = append(os.Environ(), "BDD_TEST_LOG_CHILD=1", "BDD_OUTPUT=testlog")
+			out, _ := cmd.CombinedOutput()
+
+			it("should log each context on the test running it", func(assert bdd.Assert) {
+				assert.That(string(out), bdd.MatchesRegexp(`=== RUN   Test_Output_Through_Test_Log/first\n    output_test.go:\d+: \n`))
+				assert.That(string(out), bdd.MatchesRegexp(`(?s)/first\n.*Given a first context.*It should fail on first.*=== RUN   Test_Output_Through_Test_Log/second`))
+				assert.Contains(string(out), "--- FAIL: Test_Output_Through_Test_Log/first")
+			})
+
+			it("should keep contexts of each test apart", func(assert bdd.Assert) {
+				assert.That(string(out), bdd.MatchesRegexp(`(?s)=== RUN   Test_Output_Through_Test_Log/second\n.*Given a second context.*It should pass on second`))
+				assert.That(string(out), bdd.Not(bdd.MatchesRegexp(`(?s)=== RUN   Test_Output_Through_Test_Log/first\n[^=]*second context`)))
+				assert.Contains(string(out), "--- PASS: Test_Output_Through_Test_Log/second")
+			})
+		})
+	})
+}
+
+// Test_Output_Through_Test_Log runs specs on subtests, logging through
+// t.Log, only when run as a child of Test_Output_Destinations.
+func Test_Output_Through_Test_Log(t *testing.T) {
+	if os.Getenv("BDD_TEST_LOG_CHILD") == "" {
+		t.Skip("run by Test_Output_Destinations")
+	}
+
+	for _, name := range []string{"first", "second"} {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			given := bdd.Sentences().Given()
+
+			given(t, "a "+name+" context", func(when bdd.When) {
+				when("it runs", func(it bdd.It) {
+					it("should fail on first", func(assert bdd.Assert) {
+						assert.NotEqual("first", name)
+					})
+					it("should pass on second", func(assert bdd.Assert) {
+						assert.True(true)
+					})
+				})
+			})
+		})
+	}
+}
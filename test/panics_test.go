@@ -0,0 +1,60 @@
+package test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+)
+
+// panicsSpec runs a context where the panicked value differs from the
+// expected one.
+func panicsSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a function panicking", func(when bdd.When) {
+		when("checked for another value", func(it bdd.It) {
+			it("should panic with it", func(assert bdd.Assert) {
+				assert.PanicsWithValue("calm", func() { panic("crazy") })
+			})
+		})
+	})
+}
+
+func Test_Panics_And_Float_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "functions and numbers to check", func(when bdd.When) {
+		when("asserted inside an It body", func(it bdd.It) {
+			it("should check code panicking or not", func(assert bdd.Assert) {
+				assert.Panics(func() { panic("crazy") })
+				assert.NotPanics(func() {})
+				assert.PanicsWithValue("crazy", func() { panic("crazy") })
+				assert.PanicsWithError("crazy", func() { panic(errors.New("crazy")) })
+			})
+
+			it("should check float maths", func(assert bdd.Assert) {
+				assert.InDelta(math.Pi, 22/7.0, 0.01)
+				assert.InEpsilon(100, 101, 0.02)
+			})
+
+			it("should check complex conditions", func(assert bdd.Assert) {
+				assert.Condition(func() bool { return math.Sqrt(4) == 2 })
+			})
+		})
+
+		when("the panicked value is not the expected one", func(it bdd.It) {
+			r := &recorder{}
+			record(panicsSpec, r)
+
+			it("should fail informing both values", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					m := r.events[3].Failures[0].Message
+					assert.Contains(m, `"calm"`)
+					assert.Contains(m, `"crazy"`)
+				}
+			})
+		})
+	})
+}
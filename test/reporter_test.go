@@ -0,0 +1,171 @@
+package test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// recorder is a spec.Reporter storing every event received.
+type recorder struct {
+	kinds  []string
+	events []spec.Event
+}
+
+func (r *recorder) add(kind string, e spec.Event) {
+	r.kinds = append(r.kinds, kind)
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) FeatureStarted(e spec.Event) { r.add("feature", e) }
+func (r *recorder) GivenStarted(e spec.Event)   { r.add("given", e) }
+func (r *recorder) WhenStarted(e spec.Event)    { r.add("when", e) }
+func (r *recorder) ItPassed(e spec.Event)       { r.add("passed", e) }
+func (r *recorder) ItFailed(e spec.Event)       { r.add("failed", e) }
+func (r *recorder) ItPending(e spec.Event)      { r.add("pending", e) }
+func (r *recorder) ItSkipped(e spec.Event)      { r.add("skipped", e) }
+func (r *recorder) GivenFinished(e spec.Event)  { r.add("finished", e) }
+
+// record runs fn with rs as the only reporters, restoring the previous
+// ones after.
+func record(fn func(), rs ...spec.Reporter) {
+	previous := spec.Reporters()
+	defer spec.SetReporters(previous...)
+
+	spec.SetReporters(rs...)
+	fn()
+}
+
+// recordClosed runs fn with rs as the only reporters, closing them
+// after, as spec.Main() does, and restoring the previous ones.
+func recordClosed(fn func(), rs ...spec.Reporter) (err error) {
+	record(func() {
+		fn()
+		err = spec.Close()
+	}, rs...)
+	return
+}
+
+// sampleSpec runs a context with passing, failing and pending
+// verifications, to be recorded by reporters.
+func sampleSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a context", func(when bdd.When) {
+		when("an event", func(it bdd.It) {
+			it("should pass", func(assert bdd.Assert) {
+				assert.True(true)
+			})
+			it("should fail", func(assert bdd.Assert) {
+				assert.Equal(1, 2)
+			})
+			it("should be pending")
+		})
+	})
+}
+
+func Test_Reporter_Events(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "two reporters registered", func(when bdd.When) {
+		first, second := &recorder{}, &recorder{}
+
+		when("a spec with passing, failing and pending verifications runs", func(it bdd.It) {
+			record(sampleSpec, first, second)
+
+			it("should send the events in order", func(assert bdd.Assert) {
+				assert.Equal([]string{"feature", "given", "when", "passed", "failed", "pending", "finished"}, first.kinds)
+			})
+
+			it("should send the same events to every reporter", func(assert bdd.Assert) {
+				assert.Equal(first.kinds, second.kinds)
+			})
+
+			it("should inform the path of verification", func(assert bdd.Assert) {
+				e := first.events[4]
+				assert.Equal("sampleSpec", e.Feature)
+				assert.Equal("a context", e.Given)
+				assert.Equal("an event", e.When)
+				assert.Equal("should fail", e.It)
+				assert.Equal(spec.StatusFailed, e.Status)
+			})
+
+			it("should inform skipped verifications", func(assert bdd.Assert) {
+				skipped := &recorder{}
+				record(skippedSpec, skipped)
+
+				if assert.Len(skipped.kinds, 5) {
+					assert.Equal("skipped", skipped.kinds[3])
+					assert.Equal(spec.StatusSkipped, skipped.events[3].Status)
+				}
+			})
+
+			it("should inform failure data on failed verification", func(assert bdd.Assert) {
+				if assert.Len(first.events[4].Failures, 1) {
+					f := first.events[4].Failures[0]
+					assert.Contains(f.Message, "Not equal")
+					assert.Contains(f.File, "reporter_test.go")
+					assert.Len(f.Excerpt, 3)
+				}
+			})
+		})
+	})
+}
+
+func Test_Reports_Without_Close(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "reports enabled on a package without TestMain", func(when bdd.When) {
+		dir := t.TempDir()
+		junit, tap := filepath.Join(dir, "junit.xml"), filepath.Join(dir, "specs.tap")
+
+		when("a test running specs ends, and reporters are never closed", func(it bdd.It) {
+			record(func() {
+				t.Run("inner", passingSpec)
+			}, spec.NewJUnitReporter(junit), spec.NewTAPReporter(tap))
+
+			it("should have written every report", func(assert bdd.Assert) {
+				junitBytes, junitErr := ioutil.ReadFile(junit)
+				tapBytes, tapErr := ioutil.ReadFile(tap)
+
+				if assert.NoError(junitErr) && assert.NoError(tapErr) {
+					assert.Contains(string(junitBytes), "It should pass")
+					assert.Contains(string(tapBytes), "1..1")
+				}
+			})
+		})
+	})
+}
+
+func Test_Reports_Failing_To_Write(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "reports on a path that can't be written", func(when bdd.When) {
+		blocker := filepath.Join(t.TempDir(), "file")
+		_ = ioutil.WriteFile(blocker, nil, 0644)
+
+		when("the specs run and reporters are closed", func(it bdd.It) {
+			var err error
+			run := func() {
+				err = recordClosed(sampleSpec,
+					spec.NewJUnitReporter(filepath.Join(blocker, "junit.xml")),
+					spec.NewTAPReporter(filepath.Join(blocker, "specs.tap")),
+				)
+			}
+
+			it("should not panic", func(assert bdd.Assert) {
+				assert.NotPanics(run)
+			})
+
+			it("should tell every report failed", func(assert bdd.Assert) {
+				if assert.Error(err) {
+					assert.Contains(err.Error(), "failed to write JUnit report")
+					assert.Contains(err.Error(), "failed to write TAP report")
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,66 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/ddsgok/bdd"
+)
+
+// requireSpec runs a context where a required pet is missing, and
+// tells if the teardown ran.
+func requireSpec(tornDown *bool) func() {
+	return func() {
+		given := bdd.Sentences().Given()
+		setup := bdd.Setup(func() {}, func() { *tornDown = true })
+
+		given(&testing.T{}, "a missing pet", func(when bdd.When) {
+			var p *pet
+
+			when("its name is checked", func(it bdd.It) {
+				it("should be Rex", setup(func(assert bdd.Assert) {
+					assert.Require().NotNil(p)
+					assert.Equal("Rex", p.Name)
+				}))
+
+				it("should still run the next verification", func(assert bdd.Assert) {
+					assert.Nil(p)
+				})
+			})
+		})
+	}
+}
+
+func Test_Require_Assertions(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a required assertion failing", func(when bdd.When) {
+		r := &recorder{}
+		tornDown := false
+
+		when("the verification runs", func(it bdd.It) {
+			record(requireSpec(&tornDown), r)
+
+			it("should stop the verification on the failure", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					assert.Contains(r.events[3].Failures[0].Message, "Expected not to be nil")
+				}
+			})
+
+			it("should keep running the rest of the tree", func(assert bdd.Assert) {
+				assert.Equal([]string{"feature", "given", "when", "failed", "passed", "finished"}, r.kinds)
+			})
+
+			it("should still tear down the verification", func(assert bdd.Assert) {
+				assert.True(tornDown)
+			})
+		})
+
+		when("it succeeds", func(it bdd.It) {
+			it("should let the verification go on", func(assert bdd.Assert) {
+				p := &pet{Name: "Rex"}
+				assert.Require().NotNil(p)
+				assert.Equal("Rex", p.Name)
+			})
+		})
+	})
+}
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// failingExprSpec runs a context with an assertion failing after some
+// indentation.
+func failingExprSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a failing expression", func(when bdd.When) {
+		when("reported", func(it bdd.It) {
+			it("should be located", func(assert bdd.Assert) {
+				assert.True(false)
+			})
+		})
+	})
+}
+
+func Test_Source_Locations(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a failed assertion", func(when bdd.When) {
+		when("its failure is built", func(it bdd.It) {
+			f := failureOf(func(assert bdd.Assert) {
+				assert.True(false)
+			})
+
+			it("should have the column of failing call", func(assert bdd.Assert) {
+				assert.Equal(5, f.Column)
+				assert.Contains(f.Location(), "source_test.go:")
+				assert.Contains(f.Location(), ":5")
+			})
+
+			it("should mark the failing expression on excerpt", func(assert bdd.Assert) {
+				for _, l := range f.Excerpt {
+					if l.Number == f.Line {
+						assert.Equal("assert.True(false)", l.Text[l.Start:l.End])
+					}
+				}
+			})
+		})
+
+		when("printed without colors", func(it bdd.It) {
+			previous := *spec.Config()
+			buffer := &bytes.Buffer{}
+			_ = os.Setenv("NO_COLOR", "1")
+			printTo(failingExprSpec, func() {
+				spec.SetWriter(buffer)
+				_ = spec.SetTheme("default")
+			})
+			_ = os.Unsetenv("NO_COLOR")
+			spec.SetConfig(previous)
+
+			it("should show the path relative to module, with column", func(assert bdd.Assert) {
+				assert.Contains(buffer.String(), "in test/source_test.go:")
+			})
+
+			it("should underline the failing expression with carets", func(assert bdd.Assert) {
+				assert.Contains(buffer.String(), "^"+strings.Repeat("~", len("assert.True(false)")-1)+"\n")
+			})
+		})
+
+		when("printed with colors", func(it bdd.It) {
+			previous := *spec.Config()
+			buffer := &bytes.Buffer{}
+			t.Setenv("FORCE_COLOR", "1")
+			printTo(failingExprSpec, func() {
+				spec.SetWriter(buffer)
+				_ = spec.SetTheme("default")
+			})
+			spec.SetConfig(previous)
+
+			linked := &bytes.Buffer{}
+			printTo(failingExprSpec, func() {
+				spec.SetWriter(linked)
+				_ = spec.SetTheme("default")
+				spec.Config().Hyperlinks = true
+			})
+			spec.SetConfig(previous)
+
+			it("should not link to the file, as colors are forced", func(assert bdd.Assert) {
+				assert.NotContains(buffer.String(), "\033]8;;file://")
+			})
+
+			it("should link to the file when hyperlinks are turned on", func(assert bdd.Assert) {
+				assert.Contains(linked.String(), "\033]8;;file://")
+			})
+
+			it("should highlight syntax of excerpt", func(assert bdd.Assert) {
+				assert.Contains(buffer.String(), "\033[1;35mfunc")
+			})
+		})
+	})
+}
@@ -0,0 +1,177 @@
+package test
+
+import (
+	"flag"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// strictSpec runs a context with a pending verification and an
+// undefined situation, on strict mode, allowing paths as pending.
+func strictSpec(allowed ...string) {
+	previous := *spec.Config()
+	defer spec.SetConfig(previous)
+
+	spec.SetStrict(true)
+	spec.Config().AllowedPending = allowed
+
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a strict context", func(when bdd.When) {
+		when("an event", func(it bdd.It) {
+			it("should be pending")
+		})
+		when("an undefined event")
+	})
+}
+
+// goldenStrictSpec runs a golden context without body, on strict mode,
+// reading its cases from testdata/GoldenStrictSpec.json.
+func goldenStrictSpec() {
+	previous := *spec.Config()
+	defer spec.SetConfig(previous)
+
+	spec.SetStrict(true)
+
+	given := bdd.Sentences().Golden()
+	given(&testing.T{}, "an undefined golden context")
+}
+
+// closedOnStrict runs fn and closes reporters, on strict mode allowing
+// paths as pending, as if every test had run, without -test.short or
+// -test.failfast, returning the error of closing.
+func closedOnStrict(fn func(), allowed ...string) (err error) {
+	previous := *spec.Config()
+	defer spec.SetConfig(previous)
+
+	for _, name := range []string{"test.run", "test.short", "test.failfast"} {
+		f := flag.Lookup(name)
+		value := f.Value.String()
+		defer func() { _ = f.Value.Set(value) }()
+		_ = f.Value.Set(f.DefValue)
+	}
+
+	spec.SetStrict(true)
+	spec.Config().AllowedPending = allowed
+	err = recordClosed(fn, &recorder{})
+	return
+}
+
+func Test_Strict_Mode(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "strict mode on", func(when bdd.When) {
+		when("pending and undefined specifications run", func(it bdd.It) {
+			r := &recorder{}
+			record(func() { strictSpec() }, r)
+
+			it("should report them as failed", func(assert bdd.Assert) {
+				assert.Equal([]string{"feature", "given", "when", "failed", "when", "failed", "finished"}, r.kinds)
+			})
+
+			it("should fail pending verification at its line", func(assert bdd.Assert) {
+				if assert.Len(r.events[3].Failures, 1) {
+					f := r.events[3].Failures[0]
+					assert.Contains(f.Message, "NOT IMPLEMENTED")
+					assert.Contains(f.File, "strict_test.go")
+					assert.Len(f.Excerpt, 3)
+				}
+			})
+
+			it("should fail undefined situation with its path", func(assert bdd.Assert) {
+				assert.Equal("Given a strict context When an undefined event", r.events[5].Path())
+				if assert.Len(r.events[5].Failures, 1) {
+					assert.Contains(r.events[5].Failures[0].Message, "UNDEFINED")
+				}
+			})
+		})
+
+		when("their paths are allowed", func(it bdd.It) {
+			r := &recorder{}
+			record(func() {
+				strictSpec(
+					"Given a strict context When an event It should be pending",
+					"Given a strict context When an undefined event",
+				)
+			}, r)
+
+			it("should report them as pending", func(assert bdd.Assert) {
+				assert.Equal([]string{"feature", "given", "when", "pending", "when", "pending", "finished"}, r.kinds)
+			})
+		})
+
+		when("some allowed paths match no specification", func(it bdd.It) {
+			err := closedOnStrict(func() {
+				strictSpec("Given a strict context When an event It should be pending")
+			},
+				"Given a strict context When an event It should be pending",
+				"Given a removed context",
+			)
+
+			it("should tell them when closing", func(assert bdd.Assert) {
+				if assert.Error(err) {
+					assert.Contains(err.Error(), "Given a removed context")
+					assert.NotContains(err.Error(), "It should be pending")
+				}
+			})
+		})
+
+		when("some allowed paths match no specification, after a test skipped", func(it bdd.It) {
+			err := closedOnStrict(func() {
+				t.Run("skipped", func(t *testing.T) {
+					bdd.Sentences().Given()(t, "a skipped context", func(when bdd.When) {
+						when("an event", func(it bdd.It) {
+							it("should pass", func(assert bdd.Assert) {
+								assert.True(true)
+							})
+						})
+					})
+					t.Skip("skipped on purpose")
+				})
+			},
+				"Given a removed context",
+			)
+
+			it("should not fail when closing", func(assert bdd.Assert) {
+				assert.NoError(err)
+			})
+		})
+
+		when("a golden context without body runs", func(it bdd.It) {
+			r := &recorder{}
+			record(goldenStrictSpec, r)
+
+			it("should report it as failed", func(assert bdd.Assert) {
+				assert.Equal([]string{"feature", "given", "failed", "finished"}, r.kinds)
+			})
+		})
+	})
+
+	given(t, "strict mode set by environment", func(when bdd.When) {
+		when("the allow-list file is missing", func(it bdd.It) {
+			cmd := exec.Command(os.Args[0], "-test.run=^Test_Colors_Width$")
+			cmd.Env = append(os.Environ(), "BDD_STRICT=1", "BDD_ALLOW_PENDING=missing-allow-list.txt")
+			out, err := cmd.CombinedOutput()
+
+			it("should fail the test with the error", func(assert bdd.Assert) {
+				assert.Error(err)
+				assert.Contains(string(out), "--- FAIL: Test_Colors_Width")
+				assert.Contains(string(out), "failed to read allowed pending specifications")
+			})
+		})
+	})
+
+	given(t, "strict mode off", func(when bdd.When) {
+		when("an allow-list is read from a missing file", func(it bdd.It) {
+			err := spec.LoadAllowedPending("missing-allow-list.txt")
+
+			it("should return an error", func(assert bdd.Assert) {
+				assert.Error(err)
+			})
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// passingSpec runs a context with a single passing verification, on t.
+func passingSpec(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a passing context", func(when bdd.When) {
+		when("an event", func(it bdd.It) {
+			it("should pass", func(assert bdd.Assert) {
+				assert.True(true)
+			})
+		})
+	})
+}
+
+func Test_Summary(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a summary reporter", func(when bdd.When) {
+		when("reporters are closed after a spec runs", func(it bdd.It) {
+			buffer := &bytes.Buffer{}
+			printTo(func() {
+				summary := spec.NewSummaryReporter()
+				record(sampleSpec, summary)
+				_ = summary.(io.Closer).Close()
+			}, func() {
+				spec.SetWriter(buffer)
+			})
+			out := buffer.String()
+
+			it("should print the summary of all specifications", func(assert bdd.Assert) {
+				assert.Contains(out, "Summary of all specifications: 3 verifications")
+			})
+
+			it("should count verifications by status for each feature", func(assert bdd.Assert) {
+				assert.True(regexp.MustCompile(`sampleSpec\s+1\s+1\s+1\s+0`).MatchString(out))
+				assert.True(regexp.MustCompile(`Total\s+1\s+1\s+1\s+0`).MatchString(out))
+			})
+
+			it("should list the slowest verifications", func(assert bdd.Assert) {
+				assert.Contains(out, "Slowest verifications:")
+			})
+
+			it("should list failed verifications with their location", func(assert bdd.Assert) {
+				assert.Contains(out, "Failed verifications:")
+				assert.True(regexp.MustCompile(`Given a context When an event It should fail\s+at reporter_test.go:\d+`).MatchString(out))
+			})
+		})
+
+		when("a test function ends", func(it bdd.It) {
+			buffer := &bytes.Buffer{}
+			printTo(func() {
+				record(func() {
+					t.Run("inner", passingSpec)
+				}, spec.NewSummaryReporter())
+			}, func() {
+				spec.SetWriter(buffer)
+			})
+
+			it("should print the summary of test", func(assert bdd.Assert) {
+				assert.Contains(buffer.String(), "Summary of "+t.Name()+"/inner: 1 verification in")
+			})
+		})
+
+		when("reporters are closed on quiet verbosity", func(it bdd.It) {
+			summaryOn := func(fn func()) (out string) {
+				defer spec.SetVerbosity(spec.Config().Verbosity)
+
+				buffer := &bytes.Buffer{}
+				printTo(func() {
+					summary := spec.NewSummaryReporter()
+					record(fn, summary)
+					_ = summary.(io.Closer).Close()
+				}, func() {
+					spec.SetWriter(buffer)
+					spec.SetVerbosity(spec.VerbosityQuiet)
+				})
+				out = buffer.String()
+				return
+			}
+
+			it("should print nothing when every verification passes", func(assert bdd.Assert) {
+				assert.Empty(summaryOn(func() { passingSpec(&testing.T{}) }))
+			})
+
+			it("should print the summary when a verification fails", func(assert bdd.Assert) {
+				assert.Contains(summaryOn(sampleSpec), "Summary of all specifications: 3 verifications")
+			})
+		})
+	})
+}
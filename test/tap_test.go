@@ -0,0 +1,86 @@
+package test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// skippedSpec runs a context with a skipped verification.
+func skippedSpec() {
+	given := bdd.Sentences().Given()
+
+	given(&testing.T{}, "a context to skip", func(when bdd.When) {
+		when("an event", func(it bdd.It) {
+			it("should be skipped", bdd.Skip(func(assert bdd.Assert) {
+				assert.True(false)
+			}))
+		})
+	})
+}
+
+func Test_TAP_Reporter(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a TAP reporter streaming to a buffer", func(when bdd.When) {
+		out := &bytes.Buffer{}
+
+		when("specs with passing, failing, pending and skipped verifications run", func(it bdd.It) {
+			tap := spec.NewTAPStreamReporter(out)
+			_ = recordClosed(func() {
+				sampleSpec()
+				skippedSpec()
+			}, tap)
+			lines := strings.Split(out.String(), "\n")
+
+			it("should start with TAP version", func(assert bdd.Assert) {
+				assert.Equal("TAP version 13", lines[0])
+			})
+
+			it("should number points named by sentences path", func(assert bdd.Assert) {
+				assert.Equal("ok 1 - Given a context When an event It should pass", lines[1])
+				assert.Equal("not ok 2 - Given a context When an event It should fail", lines[2])
+			})
+
+			it("should have a YAML diagnostic block on failure", func(assert bdd.Assert) {
+				tap := out.String()
+				assert.Contains(tap, "  ---\n  severity: fail\n")
+				assert.Contains(tap, "  message: |\n    Error:")
+				assert.Contains(tap, "reporter_test.go")
+				assert.Contains(tap, "  line: ")
+				assert.Contains(tap, "  excerpt: |\n")
+				assert.Contains(tap, "  ...\n")
+			})
+
+			it("should mark pending points as TODO and skipped ones as SKIP", func(assert bdd.Assert) {
+				tap := out.String()
+				assert.Contains(tap, "not ok 3 - Given a context When an event It should be pending # TODO not implemented\n")
+				assert.Contains(tap, "ok 4 - Given a context to skip When an event It should be skipped # SKIP\n")
+			})
+
+			it("should end with the plan", func(assert bdd.Assert) {
+				assert.True(strings.HasSuffix(out.String(), "\n1..4\n"))
+			})
+		})
+	})
+
+	given(t, "a TAP reporter writing to a file", func(when bdd.When) {
+		file := filepath.Join(t.TempDir(), "specs.tap")
+
+		when("a spec runs", func(it bdd.It) {
+			_ = recordClosed(sampleSpec, spec.NewTAPReporter(file))
+			bytes, err := ioutil.ReadFile(file)
+
+			it("should have the plan at the top", func(assert bdd.Assert) {
+				if assert.NoError(err) {
+					assert.True(strings.HasPrefix(string(bytes), "TAP version 13\n1..3\nok 1 - "))
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,151 @@
+package test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// printThemed runs sampleSpec with theme, and environment variable env
+// set to value, as the only one detecting colors, for the rest of test
+// t, returning the output printed.
+func printThemed(t *testing.T, theme, env, value string) (out string) {
+	previous := *spec.Config()
+	defer spec.SetConfig(previous)
+
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("FORCE_COLOR", "")
+	t.Setenv(env, value)
+
+	buffer := &bytes.Buffer{}
+	printTo(sampleSpec, func() {
+		spec.SetWriter(buffer)
+		_ = spec.SetTheme(theme)
+	})
+
+	out = buffer.String()
+	return
+}
+
+func Test_Themes(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "the default theme", func(when bdd.When) {
+		when("colors are forced with FORCE_COLOR", func(it bdd.It) {
+			out := printThemed(t, "default", "FORCE_COLOR", "1")
+
+			it("should print ansi codes", func(assert bdd.Assert) {
+				assert.Contains(out, "\033[")
+			})
+		})
+
+		when("colors are disabled with NO_COLOR", func(it bdd.It) {
+			out := printThemed(t, "default", "NO_COLOR", "1")
+
+			it("should print no ansi codes", func(assert bdd.Assert) {
+				assert.NotContains(out, "\033[")
+				assert.Contains(out, "» It should pass")
+			})
+		})
+	})
+
+	given(t, "the monochrome theme", func(when bdd.When) {
+		when("colors are forced with FORCE_COLOR", func(it bdd.It) {
+			out := printThemed(t, "monochrome", "FORCE_COLOR", "1")
+
+			it("should print no ansi codes", func(assert bdd.Assert) {
+				assert.NotContains(out, "\033[")
+			})
+
+			it("should mark verifications by status", func(assert bdd.Assert) {
+				assert.Contains(out, "✓ It should pass")
+				assert.Contains(out, "✗ It should fail")
+				assert.Contains(out, "• It should be pending «-- NOT IMPLEMENTED")
+			})
+		})
+	})
+
+	given(t, "the light-background theme", func(when bdd.When) {
+		when("colors are forced with FORCE_COLOR", func(it bdd.It) {
+			out := printThemed(t, "light-background", "FORCE_COLOR", "1")
+
+			it("should print ansi codes", func(assert bdd.Assert) {
+				assert.Contains(out, "\033[")
+			})
+		})
+	})
+
+	given(t, "a theme applied with colors forced", func(when bdd.When) {
+		previous := *spec.Config()
+		defer spec.SetConfig(previous)
+
+		t.Setenv("NO_COLOR", "")
+		t.Setenv("FORCE_COLOR", "1")
+		_ = spec.SetTheme("default")
+		forced := spec.Config().AnsiOfGiven
+
+		when("output changes to a writer without colors", func(it bdd.It) {
+			t.Setenv("FORCE_COLOR", "0")
+			spec.SetWriter(&bytes.Buffer{})
+			written := spec.Config().AnsiOfGiven
+
+			it("should detect colors again", func(assert bdd.Assert) {
+				assert.NotEmpty(forced)
+				assert.Empty(written)
+			})
+		})
+	})
+
+	given(t, "colors forced on a writer", func(when bdd.When) {
+		previous := *spec.Config()
+		defer spec.SetConfig(previous)
+
+		t.Setenv("NO_COLOR", "")
+		t.Setenv("FORCE_COLOR", "1")
+		spec.SetWriter(&bytes.Buffer{})
+
+		when("a theme is applied", func(it bdd.It) {
+			_ = spec.SetTheme("default")
+			hyperlinks := spec.Config().Hyperlinks
+
+			it("should not link locations", func(assert bdd.Assert) {
+				assert.False(hyperlinks)
+			})
+		})
+
+		when("a theme turning hyperlinks on is applied", func(it bdd.It) {
+			spec.AddTheme("linked", spec.Theme{Mark: "»", MarkWithError: "»", MarkNotImplemented: "»", Hyperlinks: true})
+			_ = spec.SetTheme("linked")
+			hyperlinks := spec.Config().Hyperlinks
+
+			it("should link locations", func(assert bdd.Assert) {
+				assert.True(hyperlinks)
+			})
+		})
+	})
+
+	given(t, "an unknown theme", func(when bdd.When) {
+		when("it's set", func(it bdd.It) {
+			err := spec.SetTheme("unknown")
+
+			it("should return an error", func(assert bdd.Assert) {
+				assert.Error(err)
+			})
+		})
+
+		when("it's set by BDD_THEME", func(it bdd.It) {
+			cmd := exec.Command(os.Args[0], "-test.run=^Test_Colors_Width$")
+			cmd.Env = append(os.Environ(), "BDD_THEME=unknown")
+			out, err := cmd.CombinedOutput()
+
+			it("should tell it's invalid and run with default theme", func(assert bdd.Assert) {
+				assert.NoError(err)
+				assert.Contains(string(out), `bdd: invalid value "unknown" for flag -bdd.theme`)
+			})
+		})
+	})
+}
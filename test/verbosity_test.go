@@ -0,0 +1,108 @@
+package test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ddsgok/bdd"
+	"github.com/ddsgok/bdd/spec"
+)
+
+// printVerbose runs sampleSpec with verbosity v, returning the output
+// printed.
+func printVerbose(v spec.Verbosity) (out string) {
+	previous := *spec.Config()
+	defer spec.SetConfig(previous)
+
+	buffer := &bytes.Buffer{}
+	printTo(sampleSpec, func() {
+		spec.SetWriter(buffer)
+		spec.SetVerbosity(v)
+	})
+
+	out = buffer.String()
+	return
+}
+
+func Test_Verbosity(t *testing.T) {
+	given := bdd.Sentences().Given()
+
+	given(t, "a spec with passing, failing and pending verifications", func(when bdd.When) {
+		when("printed on quiet verbosity", func(it bdd.It) {
+			out := printVerbose(spec.VerbosityQuiet)
+
+			it("should print the path of failed verification", func(assert bdd.Assert) {
+				assert.Contains(out, "Feature: sampleSpec")
+				assert.Contains(out, "Given a context")
+				assert.Contains(out, "When an event")
+				assert.Contains(out, "It should fail")
+			})
+
+			it("should not print other verifications", func(assert bdd.Assert) {
+				assert.NotContains(out, "should pass")
+				assert.NotContains(out, "should be pending")
+			})
+		})
+
+		when("printed on progress verbosity", func(it bdd.It) {
+			out := printVerbose(spec.VerbosityProgress)
+
+			it("should print a character for each verification", func(assert bdd.Assert) {
+				assert.Contains(out, ".F*\n")
+			})
+
+			it("should print the failed verification path at the end", func(assert bdd.Assert) {
+				assert.Contains(out, "Given a context When an event It should fail")
+				assert.NotContains(out, "Feature: sampleSpec")
+			})
+		})
+
+		when("printed on debug verbosity", func(it bdd.It) {
+			out := printVerbose(spec.VerbosityDebug)
+
+			it("should print the whole tree with durations", func(assert bdd.Assert) {
+				assert.Contains(out, "Feature: sampleSpec")
+				assert.Contains(out, "It should pass (")
+				assert.Contains(out, "It should be pending (")
+			})
+		})
+	})
+
+	given(t, "a spec with only passing verifications", func(when bdd.When) {
+		when("printed on quiet verbosity, along with summaries", func(it bdd.It) {
+			previous := *spec.Config()
+			buffer := &bytes.Buffer{}
+			printTo(func() {
+				summary := spec.NewSummaryReporter()
+				_ = recordClosed(func() {
+					t.Run("quiet", passingSpec)
+				}, spec.NewConsoleReporter(), summary)
+			}, func() {
+				spec.SetWriter(buffer)
+				spec.SetVerbosity(spec.VerbosityQuiet)
+			})
+			spec.SetConfig(previous)
+
+			it("should print nothing", func(assert bdd.Assert) {
+				assert.Empty(buffer.String())
+			})
+		})
+	})
+
+	given(t, "a spec with arguments", func(when bdd.When) {
+		when("printed on debug verbosity", func(it bdd.It) {
+			previous := *spec.Config()
+			buffer := &bytes.Buffer{}
+			printTo(likeSpec, func() {
+				spec.SetWriter(buffer)
+				spec.SetVerbosity(spec.VerbosityDebug)
+			})
+			spec.SetConfig(previous)
+
+			it("should print argument values of each verification", func(assert bdd.Assert) {
+				assert.Contains(buffer.String(), "[1 2]")
+				assert.Contains(buffer.String(), "[3 7]")
+			})
+		})
+	})
+}
@@ -6,6 +6,17 @@ type testFunc struct {
 	fn interface{}
 }
 
+// skippedFunc wraps a test function marked to not be executed.
+type skippedFunc struct {
+	fn interface{}
+}
+
+// isSkipped tells if test function was marked with Skip.
+func (tb testFunc) isSkipped() (b bool) {
+	_, b = tb.fn.(skippedFunc)
+	return
+}
+
 // asWhenFunc return test function as When function.
 func (tb testFunc) asWhenFunc() (wfn func(When, ...interface{})) {
 	if tb.fn != nil {
@@ -66,13 +77,15 @@ func (tb testFunc) asAssertFunc() (afn func(Assert, ...interface{})) {
 	return
 }
 
-// newTestFunc creates a test func using arguments. Will check for
-// errors.
+// newTestFunc creates a test func using arguments, empty when there's
+// none. Will check for errors.
 func newTestFunc(args ...interface{}) (tb testFunc) {
 	if len(args) > 1 {
 		panic(ErrWrongNumTestFuncs)
 	}
 
-	tb = testFunc{args[0]}
+	if len(args) == 1 {
+		tb = testFunc{args[0]}
+	}
 	return
 }
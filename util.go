@@ -119,3 +119,10 @@ func feature() (r string) {
 	r = strings.Replace(m, "_", " ", -1)
 	return
 }
+
+// declaredAt returns file and line where the sentence calling it was
+// declared by user.
+func declaredAt() (file string, line int) {
+	_, file, line, _ = runtime.Caller(2)
+	return
+}